@@ -1,10 +1,17 @@
 package account
 
+import "github.com/ginarea/gobybit/transport"
+
 type Response[T any] struct {
-	RetCode int    `json:"ret_code"`
-	RetMsg  string `json:"ret_msg"`
-	ExtCode string `json:"ext_code"`
-	ExtInfo string `json:"ext_info"`
-	Result  T      `json:"result"`
-	TimeNow int64  `json:"time_now"`
+	RetCode int            `json:"ret_code"`
+	RetMsg  string         `json:"ret_msg"`
+	ExtCode string         `json:"ext_code"`
+	ExtInfo string         `json:"ext_info"`
+	Result  T              `json:"result"`
+	TimeNow int64          `json:"time_now"`
+	Meta    transport.Meta `json:"-"`
+}
+
+func (this *Response[T]) SetMeta(meta transport.Meta) {
+	this.Meta = meta
 }
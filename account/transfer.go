@@ -1,6 +1,12 @@
 // Transfer Data Endpoints (https://bybit-exchange.github.io/docs/account_asset/#t-transfer_api)
 package account
 
+import (
+	"context"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 // Create Internal Transfer (https://bybit-exchange.github.io/docs/account_asset/#t-createinternaltransfer)
 type CreateInternalTransfer struct {
 	// param as json on body
@@ -39,19 +45,30 @@ func (this QueryInternalTransferList) Do(client *Client) (InternalTransfers, err
 	return Get[InternalTransfers](client, "transfer/list", this)
 }
 
+// Iterator over all transfers, following the cursor in the requested direction
+func (this QueryInternalTransferList) Iter(client *Client) *transport.Pager[InternalTransfer] {
+	return transport.NewPager(client.Context(), transport.Cursor(transport.Deref(this.Cursor), func(ctx context.Context, cursor string) ([]InternalTransfer, string, error) {
+		if cursor != "" {
+			this.Cursor = &cursor
+		}
+		r, err := GetContext[InternalTransfers](ctx, client, "transfer/list", this)
+		return r.List, r.Cursor, err
+	}))
+}
+
 type InternalTransfers struct {
 	List   []InternalTransfer `json:"list"`
 	Cursor string             `json:"cursor"`
 }
 
 type InternalTransfer struct {
-	TransferID      string         `json:"transfer_id"`
-	Coin            string         `json:"coin"`
-	Amount          string         `json:"amount"`
-	FromAccountType AccountType    `json:"from_account_type"`
-	ToAccountType   AccountType    `json:"to_account_type"`
-	Timestamp       string         `json:"timestamp"`
-	Status          TransferStatus `json:"status"`
+	TransferID      string              `json:"transfer_id"`
+	Coin            string              `json:"coin"`
+	Amount          string              `json:"amount"`
+	FromAccountType AccountType         `json:"from_account_type"`
+	ToAccountType   AccountType         `json:"to_account_type"`
+	Timestamp       transport.Timestamp `json:"timestamp"`
+	Status          TransferStatus      `json:"status"`
 }
 
 func (this *Client) QueryInternalTransferList(v QueryInternalTransferList) (InternalTransfers, error) {
@@ -1,6 +1,9 @@
 package gobybit
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/ginarea/gobybit/account"
 	"github.com/ginarea/gobybit/ifutures"
 	"github.com/ginarea/gobybit/iperpetual"
@@ -12,7 +15,11 @@ import (
 )
 
 type Client struct {
-	c *transport.Client
+	c           *transport.Client
+	clock       *transport.Clock
+	derivatives *transport.Instruments
+	spot        *transport.Instruments
+	spotv3      *transport.Instruments
 }
 
 func NewClient() *Client {
@@ -31,16 +38,89 @@ func (this *Client) WithByTickUrl() *Client {
 	return this
 }
 
+func (this *Client) WithProfile(profile transport.Profile) *Client {
+	this.c.WithProfile(profile)
+	return this
+}
+
 func (this *Client) WithAuth(key, secret string) *Client {
 	this.c.WithAuth(key, secret)
 	return this
 }
 
+func (this *Client) WithAuthSigner(key string, signer transport.Signer) *Client {
+	this.c.WithAuthSigner(key, signer)
+	return this
+}
+
 func (this *Client) WithProxy(proxy string) *Client {
 	this.c.WithProxy(proxy)
 	return this
 }
 
+func (this *Client) WithHTTPClient(client *http.Client) *Client {
+	this.c.WithHTTPClient(client)
+	return this
+}
+
+func (this *Client) WithRoundTripper(rt http.RoundTripper) *Client {
+	this.c.WithRoundTripper(rt)
+	return this
+}
+
+func (this *Client) WithRetry(policy *transport.RetryPolicy) *Client {
+	this.c.WithRetry(policy)
+	return this
+}
+
+func (this *Client) WithLimiter(limiter *transport.Limiter) *Client {
+	this.c.WithLimiter(limiter)
+	return this
+}
+
+func (this *Client) WithRecorder(recorder *transport.Recorder) *Client {
+	this.c.WithRecorder(recorder)
+	return this
+}
+
+func (this *Client) WithInterceptor(interceptors ...transport.Interceptor) *Client {
+	this.c.WithInterceptor(interceptors...)
+	return this
+}
+
+// Serve requests from a recording instead of the exchange
+func (this *Client) WithReplay(replay *transport.Replay) *Client {
+	return this.WithRoundTripper(replay)
+}
+
+func (this *Client) WithRecvWindow(recvWindow time.Duration) *Client {
+	this.c.WithRecvWindow(recvWindow)
+	return this
+}
+
+// Sign requests with time corrected against the server clock, which is re-measured with the given interval
+func (this *Client) WithClockSync(interval time.Duration) *Client {
+	this.clock = transport.NewClock()
+	this.c.WithClock(this.clock)
+	this.clock.Run(interval, this.InversePerpetual().ServerTimeValue)
+	return this
+}
+
+// Round and validate orders by symbol rules (tick size, qty step, limits) before sending.
+// Rules are loaded on first order and reloaded once older than ttl (zero: loaded once)
+func (this *Client) WithInstruments(ttl time.Duration) *Client {
+	this.derivatives = iperpetual.NewInstruments(iperpetual.NewClient(this.c)).WithTTL(ttl)
+	this.spot = spot.NewInstruments(spot.NewClient(this.c)).WithTTL(ttl)
+	this.spotv3 = spotv3.NewInstruments(spotv3.NewClient(this.c)).WithTTL(ttl)
+	return this
+}
+
+func (this *Client) Shutdown() {
+	if this.clock != nil {
+		this.clock.Shutdown()
+	}
+}
+
 func (this *Client) WithLog(log *ulog.Log) *Client {
 	this.c.WithLog(log)
 	return this
@@ -64,24 +144,28 @@ func (this *Client) Secret() string {
 	return this.c.Secret()
 }
 
+func (this *Client) Transport() *transport.Client {
+	return this.c
+}
+
 func (this *Client) InversePerpetual() *iperpetual.Client {
-	return iperpetual.NewClient(this.c)
+	return iperpetual.NewClient(this.c).WithInstruments(this.derivatives)
 }
 
 func (this *Client) UsdtPerpetual() *uperpetual.Client {
-	return uperpetual.NewClient(this.c)
+	return uperpetual.NewClient(this.c).WithInstruments(this.derivatives)
 }
 
 func (this *Client) InverseFutures() *ifutures.Client {
-	return ifutures.NewClient(this.c)
+	return ifutures.NewClient(this.c).WithInstruments(this.derivatives)
 }
 
 func (this *Client) Spot() *spot.Client {
-	return spot.NewClient(this.c)
+	return spot.NewClient(this.c).WithInstruments(this.spot)
 }
 
 func (this *Client) Spotv3() *spotv3.Client {
-	return spotv3.NewClient(this.c)
+	return spotv3.NewClient(this.c).WithInstruments(this.spotv3)
 }
 
 func (this *Client) AccountAsset() *account.Client {
@@ -0,0 +1,253 @@
+package fake
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
+type market int
+
+const (
+	marketInverse market = iota
+	marketLinear
+	marketSpot
+	marketSpotV3
+)
+
+type status int
+
+const (
+	statusNew status = iota
+	statusFilled
+	statusCancelled
+)
+
+type order struct {
+	market  market
+	id      string
+	linkID  string
+	symbol  string
+	side    string
+	kind    string
+	tif     string
+	price   float64
+	qty     float64
+	filled  float64
+	avg     float64
+	status  status
+	created time.Time
+	updated time.Time
+}
+
+func (o *order) buy() bool {
+	return strings.EqualFold(o.side, "Buy")
+}
+
+func (o *order) isMarket() bool {
+	return strings.EqualFold(o.kind, "Market")
+}
+
+func (o *order) active() bool {
+	return o.status == statusNew
+}
+
+func (o *order) leaves() float64 {
+	if o.status == statusCancelled {
+		return 0
+	}
+	return o.qty - o.filled
+}
+
+// Limit order is executable at the given last price
+func (o *order) crossed(price float64) bool {
+	if o.buy() {
+		return price <= o.price
+	}
+	return price >= o.price
+}
+
+type position struct {
+	market   market
+	symbol   string
+	size     float64 // signed: positive for long
+	entry    float64
+	realised float64
+	updated  time.Time
+}
+
+func (o *position) side() string {
+	switch {
+	case o.size > 0:
+		return "Buy"
+	case o.size < 0:
+		return "Sell"
+	}
+	return "None"
+}
+
+func (o *position) abs() float64 {
+	if o.size < 0 {
+		return -o.size
+	}
+	return o.size
+}
+
+func (o *position) apply(qty float64, price float64) {
+	switch {
+	case o.size == 0 || (o.size > 0) == (qty > 0):
+		o.entry = (o.entry*o.abs() + price*abs(qty)) / (o.abs() + abs(qty))
+	case abs(qty) <= o.abs():
+		o.realised += (price - o.entry) * -qty
+	default:
+		o.realised += (price - o.entry) * o.size
+		o.entry = price
+	}
+	o.size += qty
+	if o.size == 0 {
+		o.entry = 0
+	}
+	o.updated = time.Now()
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func (o *Server) nextID() string {
+	o.seq++
+	return strconv.Itoa(o.seq)
+}
+
+func (o *Server) place(v *order) *order {
+	now := time.Now()
+	v.id = o.nextID()
+	v.created = now
+	v.updated = now
+	o.orders = append(o.orders, v)
+	o.pushOrder(v)
+	last := o.price(v.symbol)
+	switch {
+	case v.isMarket():
+		o.fill(v, last)
+	case v.crossed(last):
+		o.fill(v, v.price)
+	}
+	return v
+}
+
+func (o *Server) fill(v *order, price float64) {
+	qty := v.leaves()
+	v.filled = v.qty
+	v.avg = price
+	v.status = statusFilled
+	v.updated = time.Now()
+	o.pushExecution(v, qty, price)
+	o.pushOrder(v)
+	if v.market == marketInverse || v.market == marketLinear {
+		p := o.position(v.market, v.symbol)
+		if v.buy() {
+			p.apply(qty, price)
+		} else {
+			p.apply(-qty, price)
+		}
+		o.pushPosition(p)
+	}
+}
+
+func (o *Server) cancel(v *order) {
+	v.status = statusCancelled
+	v.updated = time.Now()
+	o.pushOrder(v)
+}
+
+func (o *Server) position(m market, symbol string) *position {
+	key := strconv.Itoa(int(m)) + ":" + symbol
+	p, ok := o.positions[key]
+	if !ok {
+		p = &position{market: m, symbol: symbol, updated: time.Now()}
+		o.positions[key] = p
+	}
+	return p
+}
+
+func (o *Server) positionList(m market) (l []*position) {
+	for _, p := range o.positions {
+		if p.market == m {
+			l = append(l, p)
+		}
+	}
+	return
+}
+
+// Order lookup by order id or user order id
+func (o *Server) find(m market, id string, linkID string) *order {
+	for _, v := range o.orders {
+		if v.market == m && ((id != "" && v.id == id) || (id == "" && linkID != "" && v.linkID == linkID)) {
+			return v
+		}
+	}
+	return nil
+}
+
+func (o *Server) list(m market, symbol string, activeOnly bool) (l []*order) {
+	for i := len(o.orders) - 1; i >= 0; i-- {
+		v := o.orders[i]
+		if v.market == m && (symbol == "" || v.symbol == symbol) && (!activeOnly || v.active()) {
+			l = append(l, v)
+		}
+	}
+	return
+}
+
+// Finished orders from the newest, below orderId (if given), at most limit (if given)
+func (o *Server) history(m market, r *request) (l []*order) {
+	below, _ := strconv.Atoi(r.str("orderId"))
+	for _, v := range o.list(m, r.str("symbol"), false) {
+		if id, _ := strconv.Atoi(v.id); v.active() || (below > 0 && id >= below) {
+			continue
+		}
+		if limit := r.int("limit"); limit > 0 && len(l) == limit {
+			break
+		}
+		l = append(l, v)
+	}
+	return
+}
+
+func (o *order) statusName() string {
+	spot := o.market == marketSpot || o.market == marketSpotV3
+	switch {
+	case o.status == statusFilled && spot:
+		return "FILLED"
+	case o.status == statusFilled:
+		return "Filled"
+	case o.status == statusCancelled && spot:
+		return "CANCELED"
+	case o.status == statusCancelled:
+		return "Cancelled"
+	case spot:
+		return "NEW"
+	}
+	return "New"
+}
+
+// Executed value: contracts are priced in USD for inverse markets
+func (o *order) value(qty float64, price float64) float64 {
+	if o.market == marketInverse {
+		if price == 0 {
+			return 0
+		}
+		return qty / price
+	}
+	return qty * price
+}
+
+func decimal(v float64) transport.Decimal {
+	return transport.DecimalFromFloat(v)
+}
@@ -0,0 +1,404 @@
+package fake_test
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ginarea/gobybit"
+	"github.com/ginarea/gobybit/account"
+	"github.com/ginarea/gobybit/fake"
+	"github.com/ginarea/gobybit/iperpetual"
+	"github.com/ginarea/gobybit/spot"
+	"github.com/ginarea/gobybit/spotv3"
+	"github.com/ginarea/gobybit/transport"
+	"github.com/ginarea/gobybit/uperpetual"
+)
+
+// Below the default price, so a limit buy rests
+var restingPrice = transport.MustDecimal("19000")
+
+func newClient(srv *fake.Server) *gobybit.Client {
+	return gobybit.NewClient().WithUrl(srv.URL).WithAuth(fake.DefaultKey, fake.DefaultSecret)
+}
+
+func waitFor(t *testing.T, what string, f func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(2 * time.Second); !f(); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for", what)
+		}
+	}
+}
+
+// Private stream is authorized and the server has its subscriptions
+func waitSubscribed(t *testing.T, srv *fake.Server, ready func() bool, topics ...string) {
+	t.Helper()
+	waitFor(t, "auth", ready)
+	for _, topic := range topics {
+		waitFor(t, topic, func() bool { return srv.Subscribers(topic) > 0 })
+	}
+}
+
+func next[T any](t *testing.T, c *transport.WsChan[T]) T {
+	t.Helper()
+	select {
+	case v := <-c.C():
+		return v
+	case <-time.After(2 * time.Second):
+		t.Fatalf("no %T pushed", *new(T))
+	}
+	var zero T
+	return zero
+}
+
+func TestInversePerpetual(t *testing.T) {
+	srv := fake.NewServer()
+	defer srv.Close()
+	client := newClient(srv).InversePerpetual()
+	ws := iperpetual.NewWsClient().WithProfile(srv.Profile()).WithAuth(fake.DefaultKey, fake.DefaultSecret)
+	orders := ws.Private().Order().Chan(10, transport.WsBlock)
+	executions := ws.Private().Execution().Chan(10, transport.WsBlock)
+	positions := ws.Private().Position().Chan(10, transport.WsBlock)
+	ws.Run()
+	defer ws.Shutdown()
+	waitSubscribed(t, srv, ws.Ready, "order", "execution", "position")
+
+	placed, err := client.PlaceActiveOrder(iperpetual.PlaceActiveOrder{
+		Side:        iperpetual.Buy,
+		Symbol:      "BTCUSD",
+		OrderType:   iperpetual.Limit,
+		Qty:         transport.MustDecimal("100"),
+		TimeInForce: iperpetual.GoodTillCancel,
+		Price:       &restingPrice,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := next(t, orders); v[0].OrderID != placed.OrderID || v[0].OrderStatus != iperpetual.New {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+	list, err := client.OrderList(iperpetual.OrderList{Symbol: "BTCUSD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].OrderID != placed.OrderID || list.Items[0].OrderStatus != iperpetual.New {
+		t.Fatalf("listed %+v", list.Items)
+	}
+	if _, err := client.CancelOrder(iperpetual.CancelOrder{Symbol: "BTCUSD", OrderId: &placed.OrderID}); err != nil {
+		t.Fatal(err)
+	}
+	if v := next(t, orders); v[0].OrderID != placed.OrderID || v[0].OrderStatus != iperpetual.Cancelled {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+
+	filled, err := client.PlaceActiveOrder(iperpetual.PlaceActiveOrder{
+		Side:        iperpetual.Buy,
+		Symbol:      "BTCUSD",
+		OrderType:   iperpetual.Market,
+		Qty:         transport.MustDecimal("100"),
+		TimeInForce: iperpetual.GoodTillCancel,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	next(t, orders)
+	if v := next(t, executions); v[0].OrderID != filled.OrderID || v[0].ExecQty.String() != "100" {
+		t.Errorf("pushed execution %s %s", v[0].OrderID, v[0].ExecQty)
+	}
+	if v := next(t, orders); v[0].OrderID != filled.OrderID || v[0].OrderStatus != iperpetual.Filled {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+	if v := next(t, positions); v[0].Size != 100 || v[0].Side != iperpetual.Buy {
+		t.Errorf("pushed position %d %s", v[0].Size, v[0].Side)
+	}
+}
+
+func TestUsdtPerpetual(t *testing.T) {
+	srv := fake.NewServer()
+	defer srv.Close()
+	client := newClient(srv).UsdtPerpetual()
+	ws := uperpetual.NewWsPrivate(fake.DefaultKey, fake.DefaultSecret).WithProfile(srv.Profile())
+	orders := ws.Order().Chan(10, transport.WsBlock)
+	executions := ws.Execution().Chan(10, transport.WsBlock)
+	positions := ws.Position().Chan(10, transport.WsBlock)
+	ws.Run()
+	defer ws.Shutdown()
+	waitSubscribed(t, srv, ws.Ready, "order", "execution", "position")
+
+	placed, err := client.PlaceActiveOrder(uperpetual.PlaceActiveOrder{
+		Side:        uperpetual.Buy,
+		Symbol:      "BTCUSDT",
+		OrderType:   uperpetual.Limit,
+		Qty:         transport.MustDecimal("1"),
+		TimeInForce: uperpetual.GoodTillCancel,
+		Price:       &restingPrice,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := next(t, orders); v[0].OrderID != placed.OrderID || v[0].OrderStatus != uperpetual.New {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+	list, err := client.OrderList(uperpetual.OrderList{Symbol: "BTCUSDT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].OrderID != placed.OrderID || list.Items[0].OrderStatus != uperpetual.New {
+		t.Fatalf("listed %+v", list.Items)
+	}
+	if _, err := client.CancelOrder(uperpetual.CancelOrder{Symbol: "BTCUSDT", OrderID: &placed.OrderID}); err != nil {
+		t.Fatal(err)
+	}
+	if v := next(t, orders); v[0].OrderID != placed.OrderID || v[0].OrderStatus != uperpetual.Cancelled {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+
+	filled, err := client.PlaceActiveOrder(uperpetual.PlaceActiveOrder{
+		Side:        uperpetual.Buy,
+		Symbol:      "BTCUSDT",
+		OrderType:   uperpetual.Market,
+		Qty:         transport.MustDecimal("1"),
+		TimeInForce: uperpetual.GoodTillCancel,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	next(t, orders)
+	if v := next(t, executions); v[0].OrderID != filled.OrderID || v[0].ExecQty.String() != "1" {
+		t.Errorf("pushed execution %s %s", v[0].OrderID, v[0].ExecQty)
+	}
+	if v := next(t, orders); v[0].OrderID != filled.OrderID || v[0].OrderStatus != uperpetual.Filled {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+	if v := next(t, positions); v[0].Size.String() != "1" || v[0].Side != uperpetual.Buy {
+		t.Errorf("pushed position %s %s", v[0].Size, v[0].Side)
+	}
+}
+
+func TestSpotV3(t *testing.T) {
+	srv := fake.NewServer()
+	defer srv.Close()
+	client := newClient(srv).Spotv3()
+	ws := spotv3.NewWsPrivate(fake.DefaultKey, fake.DefaultSecret).WithProfile(srv.Profile())
+	orders := ws.Order().Chan(10, transport.WsBlock)
+	tickets := ws.Ticket().Chan(10, transport.WsBlock)
+	ws.Run()
+	defer ws.Shutdown()
+	waitSubscribed(t, srv, ws.Ready, "order", "ticketInfo")
+
+	placed, err := client.PlaceOrder(spotv3.PlaceOrder{
+		Symbol: "BTCUSDT",
+		Qty:    transport.MustDecimal("0.01"),
+		Side:   spotv3.Buy,
+		Type:   spotv3.Limit,
+		Price:  &restingPrice,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := next(t, orders); v[0].OrderID != placed.OrderID || v[0].OrderStatus != string(spotv3.New) {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+	open, err := client.OpenOrders(spotv3.OpenOrders{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(open) != 1 || open[0].(map[string]any)["orderId"] != placed.OrderID {
+		t.Fatalf("listed %+v", open)
+	}
+	if _, err := client.CancelOrder(spotv3.CancelOrder{OrderID: &placed.OrderID}); err != nil {
+		t.Fatal(err)
+	}
+	if v := next(t, orders); v[0].OrderID != placed.OrderID || v[0].OrderStatus != string(spotv3.Canceled) {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+
+	filled, err := client.PlaceOrder(spotv3.PlaceOrder{
+		Symbol: "BTCUSDT",
+		Qty:    transport.MustDecimal("0.01"),
+		Side:   spotv3.Buy,
+		Type:   spotv3.Market,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	next(t, orders)
+	if v := next(t, tickets); v[0].OrderID != filled.OrderID || v[0].Quantity.String() != "0.01" {
+		t.Errorf("pushed ticket %s %s", v[0].OrderID, v[0].Quantity)
+	}
+	if v := next(t, orders); v[0].OrderID != filled.OrderID || v[0].OrderStatus != string(spotv3.Filled) {
+		t.Errorf("pushed order %s %s", v[0].OrderID, v[0].OrderStatus)
+	}
+}
+
+// No private stream of spot v1 in the fake: REST only
+func TestSpot(t *testing.T) {
+	srv := fake.NewServer()
+	defer srv.Close()
+	client := newClient(srv).Spot()
+	placed, err := client.PlaceOrder(spot.PlaceOrder{
+		Symbol: "BTCUSDT",
+		Qty:    transport.MustDecimal("0.01"),
+		Side:   spot.Buy,
+		Type:   spot.Limit,
+		Price:  &restingPrice,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if placed.OrderStatus != spot.New {
+		t.Errorf("placed %s", placed.OrderStatus)
+	}
+	open, err := client.OpenOrders(spot.OpenOrders{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(open) != 1 || open[0].OrderID != placed.OrderID {
+		t.Fatalf("listed %+v", open)
+	}
+	cancelled, err := client.CancelOrder(spot.CancelOrder{OrderID: &placed.OrderID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelled.OrderStatus != spot.Canceled {
+		t.Errorf("cancelled %s", cancelled.OrderStatus)
+	}
+	if open, err = client.OpenOrders(spot.OpenOrders{}); err != nil || len(open) != 0 {
+		t.Errorf("listed %+v after cancel: %v", open, err)
+	}
+	if _, err := client.CancelOrder(spot.CancelOrder{OrderID: &placed.OrderID}); err == nil {
+		t.Error("cancelled twice")
+	}
+}
+
+// Account asset has no orders: transfers are created and listed
+func TestAccount(t *testing.T) {
+	srv := fake.NewServer()
+	defer srv.Close()
+	client := newClient(srv).AccountAsset()
+	transfer := account.CreateInternalTransfer{
+		TransferID:      "3d3c1a2e-9b4f-4e43-a1a7-8f8e9b1c2d3e",
+		Coin:            "USDT",
+		Amount:          "10",
+		FromAccountType: "SPOT",
+		ToAccountType:   "CONTRACT",
+	}
+	id, err := client.CreateInternalTransfer(transfer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != transfer.TransferID {
+		t.Errorf("transfer id %s", id)
+	}
+	if _, err := client.CreateInternalTransfer(transfer); err != nil {
+		t.Fatal("repeated transfer:", err)
+	}
+	l, err := client.QueryInternalTransferList(account.QueryInternalTransferList{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.List) != 1 || l.List[0].TransferID != id || l.List[0].Amount != "10" || l.List[0].Status != account.TransferSuccess {
+		t.Errorf("listed %+v", l.List)
+	}
+}
+
+func TestRecvWindow(t *testing.T) {
+	srv := fake.NewServer()
+	defer srv.Close()
+	tests := []struct {
+		name   string
+		offset time.Duration
+		window time.Duration
+		ok     bool
+	}{
+		{"in window", 0, 0, true},
+		{"stale", -10 * time.Second, 0, false},
+		{"stale in wide window", -10 * time.Second, 20 * time.Second, true},
+		{"ahead", 5 * time.Second, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := transport.NewClock()
+			clock.SetOffset(tt.offset)
+			client := newClient(srv).WithRecvWindow(tt.window)
+			client.Transport().WithClock(clock)
+			_, err := client.UsdtPerpetual().OrderList(uperpetual.OrderList{Symbol: "BTCUSDT"})
+			switch {
+			case tt.ok && err != nil:
+				t.Error(err)
+			case !tt.ok && !errors.Is(err, transport.ErrAuth):
+				t.Errorf("error %v, want auth", err)
+			}
+		})
+	}
+}
+
+// Requests signed in headers (v3 scheme) instead of query/body params
+type headerOrder struct {
+	transport.HeaderSign
+	Symbol string           `json:"symbol"`
+	Qty    string           `json:"orderQty"`
+	Side   spotv3.Side      `json:"side"`
+	Type   spotv3.OrderType `json:"orderType"`
+	Price  string           `json:"orderPrice"`
+}
+
+type headerQuery struct {
+	transport.HeaderSign
+	OrderID string `param:"orderId"`
+}
+
+func TestHeaderSign(t *testing.T) {
+	srv := fake.NewServer()
+	defer srv.Close()
+	var tamper func(*http.Request)
+	client := newClient(srv).WithRecvWindow(10 * time.Second).Spotv3()
+	client.Transport().WithInterceptor(func(x *transport.Exchange, next transport.Handler) error {
+		if tamper != nil {
+			tamper(x.Request)
+		}
+		return next(x)
+	})
+	order := headerOrder{Symbol: "BTCUSDT", Qty: "0.01", Side: spotv3.Buy, Type: spotv3.Limit, Price: restingPrice.String()}
+	placed, err := spotv3.Post[spotv3.OrderCreated](client, "order", order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := spotv3.Get[spotv3.Order](client, "order", headerQuery{OrderID: placed.OrderID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.OrderID != placed.OrderID {
+		t.Errorf("order %s, want %s", got.OrderID, placed.OrderID)
+	}
+
+	tests := []struct {
+		name   string
+		tamper func(*http.Request)
+		post   bool
+	}{
+		{"timestamp", func(r *http.Request) {
+			ts, _ := strconv.ParseInt(r.Header.Get("X-BAPI-TIMESTAMP"), 10, 64)
+			r.Header.Set("X-BAPI-TIMESTAMP", strconv.FormatInt(ts-1, 10))
+		}, true},
+		{"recv window", func(r *http.Request) { r.Header.Set("X-BAPI-RECV-WINDOW", "20000") }, true},
+		{"query", func(r *http.Request) { r.URL.RawQuery += "&symbol=BTCUSDT" }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tamper = tt.tamper
+			defer func() { tamper = nil }()
+			if tt.post {
+				_, err = spotv3.Post[spotv3.OrderCreated](client, "order", order)
+			} else {
+				_, err = spotv3.Get[spotv3.Order](client, "order", headerQuery{OrderID: placed.OrderID})
+			}
+			if !errors.Is(err, transport.ErrAuth) {
+				t.Errorf("error %v, want auth", err)
+			}
+		})
+	}
+}
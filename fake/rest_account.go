@@ -0,0 +1,45 @@
+package fake
+
+import (
+	"time"
+
+	"github.com/ginarea/gobybit/account"
+	"github.com/ginarea/gobybit/transport"
+)
+
+func (o *Server) transfer(r *request) result {
+	id := r.str("transfer_id")
+	if id == "" {
+		return fail(10001, "transfer_id is required")
+	}
+	for _, v := range o.transfers {
+		if v.TransferID == id {
+			return ok(struct {
+				TransferID string `json:"transfer_id"`
+			}{id})
+		}
+	}
+	o.transfers = append(o.transfers, account.InternalTransfer{
+		TransferID:      id,
+		Coin:            r.str("coin"),
+		Amount:          r.str("amount"),
+		FromAccountType: account.AccountType(r.str("from_account_type")),
+		ToAccountType:   account.AccountType(r.str("to_account_type")),
+		Timestamp:       transport.Timestamp(time.Now()),
+		Status:          account.TransferSuccess,
+	})
+	return ok(struct {
+		TransferID string `json:"transfer_id"`
+	}{id})
+}
+
+func (o *Server) transferList(r *request) result {
+	l := []account.InternalTransfer{}
+	for _, v := range o.transfers {
+		if (r.has("transfer_id") && v.TransferID != r.str("transfer_id")) || (r.has("coin") && v.Coin != r.str("coin")) {
+			continue
+		}
+		l = append(l, v)
+	}
+	return ok(account.InternalTransfers{List: l})
+}
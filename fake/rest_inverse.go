@@ -0,0 +1,176 @@
+package fake
+
+import (
+	"strings"
+
+	"github.com/ginarea/gobybit/iperpetual"
+	"github.com/ginarea/gobybit/transport"
+)
+
+func newPerpetualOrder(m market, r *request) (*order, result) {
+	v := &order{
+		market: m,
+		linkID: r.str("order_link_id"),
+		symbol: r.str("symbol"),
+		side:   r.str("side"),
+		kind:   r.str("order_type"),
+		tif:    r.str("time_in_force"),
+		price:  r.float("price"),
+		qty:    r.float("qty"),
+	}
+	switch {
+	case v.symbol == "":
+		return nil, fail(10001, "symbol is required")
+	case v.side != "Buy" && v.side != "Sell":
+		return nil, fail(10001, "invalid side")
+	case v.qty <= 0:
+		return nil, fail(10001, "invalid qty")
+	case !v.isMarket() && v.price <= 0:
+		return nil, fail(10001, "price is required for limit order")
+	}
+	return v, result{}
+}
+
+// Order id from order_id or order_link_id params
+func (o *Server) findParam(m market, r *request, id string, linkID string) *order {
+	return o.find(m, r.str(id), r.str(linkID))
+}
+
+func orderNotFound() result {
+	return fail(20001, "order not exists or too late to cancel")
+}
+
+func (o *Server) inverseCreate(r *request) result {
+	v, res := newPerpetualOrder(marketInverse, r)
+	if v == nil {
+		return res
+	}
+	return ok(inverseCreated(o.place(v)))
+}
+
+func (o *Server) inverseCancel(r *request) result {
+	v := o.findParam(marketInverse, r, "order_id", "order_link_id")
+	if v == nil || !v.active() {
+		return orderNotFound()
+	}
+	o.cancel(v)
+	return ok(iperpetual.OrderCancelled{OrderCreated: inverseCreated(v)})
+}
+
+func (o *Server) inverseCancelAll(r *request) result {
+	l := []iperpetual.CancelOrderItem{}
+	for _, v := range o.list(marketInverse, r.str("symbol"), true) {
+		o.cancel(v)
+		l = append(l, iperpetual.CancelOrderItem{
+			OrderMain:   inverseMain(v),
+			OrderID:     v.id,
+			OrderLinkID: v.linkID,
+		})
+	}
+	return ok(l)
+}
+
+func (o *Server) inverseList(r *request) result {
+	l := []iperpetual.OrderItem{}
+	for _, v := range o.list(marketInverse, r.str("symbol"), false) {
+		if s := r.str("order_status"); s != "" && !strings.Contains(s, v.statusName()) {
+			continue
+		}
+		l = append(l, iperpetual.OrderItem{OrderBase: inverseBase(v)})
+	}
+	return ok(iperpetual.OrderListResult{Items: l})
+}
+
+func (o *Server) inverseQuery(r *request) result {
+	if !r.has("order_id") && !r.has("order_link_id") {
+		l := []iperpetual.Order{}
+		for _, v := range o.list(marketInverse, r.str("symbol"), true) {
+			l = append(l, inverseOrder(v))
+		}
+		return ok(l)
+	}
+	v := o.findParam(marketInverse, r, "order_id", "order_link_id")
+	if v == nil {
+		return orderNotFound()
+	}
+	return ok(inverseOrder(v))
+}
+
+func (o *Server) inversePosition(r *request) result {
+	if r.has("symbol") {
+		return ok(inversePosition(o.position(marketInverse, r.str("symbol"))))
+	}
+	l := []iperpetual.PositionItem{}
+	for _, p := range o.positionList(marketInverse) {
+		l = append(l, inversePosition(p))
+	}
+	return ok(l)
+}
+
+func (o *Server) inverseWallet(r *request) result {
+	m := make(map[string]iperpetual.Balance)
+	for _, p := range o.positionList(marketInverse) {
+		coin := strings.TrimSuffix(p.symbol, "USD")
+		b := m[coin]
+		b.RealisedPnl += p.realised
+		b.CumRealisedPnl += p.realised
+		m[coin] = b
+	}
+	if coin := r.str("coin"); coin != "" {
+		return ok(map[string]iperpetual.Balance{coin: m[coin]})
+	}
+	return ok(m)
+}
+
+func inverseMain(v *order) iperpetual.OrderMain {
+	return iperpetual.OrderMain{
+		Symbol:      v.symbol,
+		Side:        iperpetual.Side(v.side),
+		OrderType:   iperpetual.OrderType(v.kind),
+		Price:       decimal(v.price),
+		Qty:         decimal(v.qty),
+		TimeInForce: iperpetual.TimeInForce(v.tif),
+		OrderStatus: iperpetual.OrderStatus(v.statusName()),
+		LeavesQty:   decimal(v.leaves()),
+		CreatedAt:   transport.Time(v.created),
+		UpdatedAt:   transport.Time(v.updated),
+	}
+}
+
+func inverseBase(v *order) iperpetual.OrderBase {
+	return iperpetual.OrderBase{
+		OrderMain:    inverseMain(v),
+		OrderID:      v.id,
+		OrderLinkID:  v.linkID,
+		CumExecQty:   decimal(v.filled),
+		CumExecValue: decimal(v.value(v.filled, v.avg)),
+	}
+}
+
+func inverseCreated(v *order) iperpetual.OrderCreated {
+	return iperpetual.OrderCreated{
+		OrderBase:     inverseBase(v),
+		LastExecPrice: decimal(v.avg),
+	}
+}
+
+func inverseOrder(v *order) iperpetual.Order {
+	return iperpetual.Order{
+		OrderCancelled: iperpetual.OrderCancelled{OrderCreated: inverseCreated(v)},
+		LeavesValue:    decimal(v.value(v.leaves(), v.price)),
+	}
+}
+
+func inversePosition(p *position) iperpetual.PositionItem {
+	d := iperpetual.PositionData{}
+	d.Symbol = p.symbol
+	d.Side = iperpetual.Side(p.side())
+	d.Size = int(p.abs())
+	d.EntryPrice = transport.Float64(p.entry)
+	d.RealisedPnl = transport.Float64(p.realised)
+	d.CumRealisedPnl = transport.Float64(p.realised)
+	d.Leverage = 1
+	d.PositionStatus = "Normal"
+	d.UpdatedAt = transport.Time(p.updated)
+	return iperpetual.PositionItem{Data: d, IsValid: true}
+}
@@ -0,0 +1,79 @@
+package fake
+
+import (
+	"time"
+
+	"github.com/ginarea/gobybit/iperpetual"
+	"github.com/ginarea/gobybit/spotv3"
+	"github.com/ginarea/gobybit/transport"
+)
+
+// Open times of bars in [start, end), at most limit, up to now
+func klineTimes(interval time.Duration, start time.Time, end time.Time, limit int) (l []time.Time) {
+	if interval <= 0 {
+		return
+	}
+	now := time.Now()
+	if end.IsZero() || end.After(now) {
+		end = now
+	}
+	for t := start.Truncate(interval); t.Before(end) && (limit == 0 || len(l) < limit); t = t.Add(interval) {
+		if !t.Before(start) {
+			l = append(l, t)
+		}
+	}
+	return
+}
+
+func (o *Server) inverseKline(r *request) result {
+	interval := iperpetual.KlineInterval(r.str("interval"))
+	limit := r.int("limit")
+	if limit == 0 || limit > iperpetual.KlineLimit {
+		limit = iperpetual.KlineLimit
+	}
+	price := decimal(o.price(r.str("symbol")))
+	l := []iperpetual.KlineItem{}
+	for _, t := range klineTimes(interval.Duration(), time.Unix(int64(r.int("from")), 0), time.Time{}, limit) {
+		l = append(l, iperpetual.KlineItem{
+			Symbol:   r.str("symbol"),
+			Interval: interval,
+			OpenTime: transport.Timestamp(t),
+			Open:     price,
+			High:     price,
+			Low:      price,
+			Close:    price,
+			Volume:   decimal(1),
+			Turnover: price,
+		})
+	}
+	return ok(l)
+}
+
+func (o *Server) spotV3Kline(r *request) result {
+	interval := spotv3.KlineInterval(r.str("interval"))
+	limit := r.int("limit")
+	if limit == 0 || limit > spotv3.KlineLimit {
+		limit = spotv3.KlineLimit
+	}
+	var end time.Time
+	if r.has("endTime") {
+		end = time.UnixMilli(int64(r.int("endTime")) + 1)
+	}
+	price := decimal(o.price(r.str("symbol")))
+	l := []spotv3.KlineData{}
+	for _, t := range klineTimes(interval.Duration(), time.UnixMilli(int64(r.int("startTime"))), end, limit) {
+		l = append(l, spotv3.KlineData{
+			Timestamp:     transport.Timestamp(t),
+			Symbol:        r.str("symbol"),
+			Alias:         r.str("symbol"),
+			OpenPrice:     price,
+			HighPrice:     price,
+			LowPrice:      price,
+			ClosePrice:    price,
+			TradingVolume: decimal(1),
+		})
+	}
+	return ok(struct {
+		List []spotv3.KlineData `json:"list"`
+	}{l})
+}
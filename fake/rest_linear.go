@@ -0,0 +1,109 @@
+package fake
+
+import (
+	"strings"
+
+	"github.com/ginarea/gobybit/transport"
+	"github.com/ginarea/gobybit/uperpetual"
+)
+
+func (o *Server) linearCreate(r *request) result {
+	v, res := newPerpetualOrder(marketLinear, r)
+	if v == nil {
+		return res
+	}
+	return ok(uperpetual.OrderCreated{Order: linearOrder(o.place(v))})
+}
+
+func (o *Server) linearCancel(r *request) result {
+	v := o.findParam(marketLinear, r, "order_id", "order_link_id")
+	if v == nil || !v.active() {
+		return orderNotFound()
+	}
+	o.cancel(v)
+	return ok(struct {
+		OrderID string `json:"order_id"`
+	}{v.id})
+}
+
+func (o *Server) linearCancelAll(r *request) result {
+	l := []string{}
+	for _, v := range o.list(marketLinear, r.str("symbol"), true) {
+		o.cancel(v)
+		l = append(l, v.id)
+	}
+	return ok(l)
+}
+
+func (o *Server) linearList(r *request) result {
+	l := []uperpetual.Order{}
+	for _, v := range o.list(marketLinear, r.str("symbol"), false) {
+		if s := r.str("order_status"); s != "" && !strings.Contains(s, v.statusName()) {
+			continue
+		}
+		if (r.has("order_id") && r.str("order_id") != v.id) || (r.has("order_link_id") && r.str("order_link_id") != v.linkID) {
+			continue
+		}
+		l = append(l, linearOrder(v))
+	}
+	return ok(uperpetual.OrderListResult{Items: l, CurrentPage: 1})
+}
+
+func (o *Server) linearQuery(r *request) result {
+	if !r.has("order_id") && !r.has("order_link_id") {
+		l := []uperpetual.Order{}
+		for _, v := range o.list(marketLinear, r.str("symbol"), true) {
+			l = append(l, linearOrder(v))
+		}
+		return ok(l)
+	}
+	v := o.findParam(marketLinear, r, "order_id", "order_link_id")
+	if v == nil {
+		return orderNotFound()
+	}
+	return ok(linearOrder(v))
+}
+
+func (o *Server) linearPosition(r *request) result {
+	if r.has("symbol") {
+		return ok([]uperpetual.PositionData{linearPosition(o.position(marketLinear, r.str("symbol")))})
+	}
+	l := []uperpetual.PositionItem{}
+	for _, p := range o.positionList(marketLinear) {
+		l = append(l, uperpetual.PositionItem{Data: linearPosition(p), IsValid: true})
+	}
+	return ok(l)
+}
+
+func linearOrder(v *order) uperpetual.Order {
+	return uperpetual.Order{
+		OrderID:       v.id,
+		Symbol:        v.symbol,
+		Side:          uperpetual.Side(v.side),
+		OrderType:     uperpetual.OrderType(v.kind),
+		Price:         decimal(v.price),
+		Qty:           decimal(v.qty),
+		TimeInForce:   uperpetual.TimeInForce(v.tif),
+		OrderStatus:   uperpetual.OrderStatus(v.statusName()),
+		LastExecPrice: decimal(v.avg),
+		CumExecQty:    decimal(v.filled),
+		CumExecValue:  decimal(v.value(v.filled, v.avg)),
+		OrderLinkID:   v.linkID,
+		CreatedTime:   transport.Time(v.created),
+		UpdatedTime:   transport.Time(v.updated),
+	}
+}
+
+func linearPosition(p *position) uperpetual.PositionData {
+	return uperpetual.PositionData{
+		Symbol:         p.symbol,
+		Side:           uperpetual.Side(p.side()),
+		Size:           int(p.abs()),
+		PositionValue:  p.abs() * p.entry,
+		EntryPrice:     p.entry,
+		Leverage:       1,
+		RealisedPnl:    p.realised,
+		CumRealisedPnl: p.realised,
+		Mode:           "MergedSingle",
+	}
+}
@@ -0,0 +1,189 @@
+package fake
+
+import (
+	"github.com/ginarea/gobybit/spot"
+	"github.com/ginarea/gobybit/spotv3"
+	"github.com/ginarea/gobybit/transport"
+)
+
+const accountID = "1"
+
+func newSpotOrder(m market, r *request, qty string, kind string, price string) (*order, result) {
+	v := &order{
+		market: m,
+		linkID: r.str("orderLinkId"),
+		symbol: r.str("symbol"),
+		side:   r.str("side"),
+		kind:   r.str(kind),
+		tif:    r.str("timeInForce"),
+		price:  r.float(price),
+		qty:    r.float(qty),
+	}
+	if v.tif == "" {
+		v.tif = "GTC"
+	}
+	switch {
+	case v.symbol == "":
+		return nil, fail(-1102, "symbol is required")
+	case v.qty <= 0:
+		return nil, fail(-1137, "invalid quantity")
+	case !v.isMarket() && v.price <= 0:
+		return nil, fail(-1102, "price is required for limit order")
+	}
+	return v, result{}
+}
+
+func spotNotFound() result {
+	return fail(-2013, "Order does not exist.")
+}
+
+func (o *Server) spotCreate(r *request) result {
+	v, res := newSpotOrder(marketSpot, r, "qty", "type", "price")
+	if v == nil {
+		return res
+	}
+	return ok(spotCreated(o.place(v)))
+}
+
+func (o *Server) spotQuery(r *request) result {
+	v := o.findParam(marketSpot, r, "orderId", "orderLinkId")
+	if v == nil {
+		return spotNotFound()
+	}
+	return ok(spot.Order{OrderHistoryResult: spotHistory(v)})
+}
+
+func (o *Server) spotCancel(r *request) result {
+	v := o.findParam(marketSpot, r, "orderId", "orderLinkId")
+	if v == nil || !v.active() {
+		return spotNotFound()
+	}
+	o.cancel(v)
+	return ok(spot.OrderCancelled{OrderCreated: spotCreated(v), Side: v.side})
+}
+
+func (o *Server) spotOpenOrders(r *request) result {
+	l := []spot.OrderBase{}
+	for _, v := range o.list(marketSpot, r.str("symbol"), true) {
+		l = append(l, spotBase(v))
+	}
+	return ok(l)
+}
+
+func (o *Server) spotHistory(r *request) result {
+	l := []spot.OrderHistoryResult{}
+	for _, v := range o.history(marketSpot, r) {
+		l = append(l, spotHistory(v))
+	}
+	return ok(l)
+}
+
+func (o *Server) spotV3Create(r *request) result {
+	v, res := newSpotOrder(marketSpotV3, r, "orderQty", "orderType", "orderPrice")
+	if v == nil {
+		return res
+	}
+	return ok(spotv3.OrderCreated{OrderBase: spotV3Base(o.place(v))})
+}
+
+func (o *Server) spotV3Query(r *request) result {
+	v := o.findParam(marketSpotV3, r, "orderId", "orderLinkId")
+	if v == nil {
+		return fail(12213, "Order does not exist.")
+	}
+	return ok(spotv3.Order{OpenedOrder: spotV3Opened(v)})
+}
+
+func (o *Server) spotV3Cancel(r *request) result {
+	v := o.findParam(marketSpotV3, r, "orderId", "orderLinkId")
+	if v == nil || !v.active() {
+		return fail(12213, "Order does not exist.")
+	}
+	o.cancel(v)
+	return ok(spotv3.OrderCancelled{OrderBase: spotV3Base(v), ExecQty: decimal(v.filled)})
+}
+
+func (o *Server) spotV3OpenOrders(r *request) result {
+	l := []spotv3.OpenedOrder{}
+	for _, v := range o.list(marketSpotV3, r.str("symbol"), true) {
+		l = append(l, spotV3Opened(v))
+	}
+	return ok(struct {
+		List []spotv3.OpenedOrder `json:"list"`
+	}{l})
+}
+
+func (o *Server) spotV3History(r *request) result {
+	l := []spotv3.OpenedOrder{}
+	for _, v := range o.history(marketSpotV3, r) {
+		l = append(l, spotV3Opened(v))
+	}
+	return ok(struct {
+		List []spotv3.OpenedOrder `json:"list"`
+	}{l})
+}
+
+func spotBase(v *order) spot.OrderBase {
+	return spot.OrderBase{
+		AccountID:   accountID,
+		OrderID:     v.id,
+		OrderLinkID: v.linkID,
+		Symbol:      v.symbol,
+		SymbolName:  v.symbol,
+		Price:       decimal(v.price),
+		OrigQty:     decimal(v.qty),
+		ExecutedQty: decimal(v.filled),
+		OrderType:   spot.OrderType(v.kind),
+		OrderStatus: spot.OrderStatus(v.statusName()),
+		TimeInForce: spot.TimeInForce(v.tif),
+	}
+}
+
+func spotCreated(v *order) spot.OrderCreated {
+	return spot.OrderCreated{
+		OrderBase:    spotBase(v),
+		TransactTime: transport.Timestamp(v.created),
+	}
+}
+
+func spotHistory(v *order) spot.OrderHistoryResult {
+	return spot.OrderHistoryResult{
+		OrderBase:           spotBase(v),
+		CummulativeQuoteQty: decimal(v.value(v.filled, v.avg)),
+		AvgPrice:            decimal(v.avg),
+		Time:                transport.Timestamp(v.created),
+		UpdateTime:          transport.Timestamp(v.updated),
+		IsWorking:           v.active(),
+	}
+}
+
+func spotV3Base(v *order) spotv3.OrderBase {
+	return spotv3.OrderBase{
+		AccountID:   accountID,
+		OrderID:     v.id,
+		OrderLinkID: v.linkID,
+		Symbol:      v.symbol,
+		CreatedTime: transport.Timestamp(v.created),
+		Price:       decimal(v.price),
+		OrigQty:     decimal(v.qty),
+		OrderType:   spotv3.OrderType(v.kind),
+		Side:        spotv3.Side(v.side),
+		OrderStatus: spotv3.OrderStatus(v.statusName()),
+		TimeInForce: spotv3.TimeInForce(v.tif),
+	}
+}
+
+func spotV3Opened(v *order) spotv3.OpenedOrder {
+	working := "0"
+	if v.active() {
+		working = "1"
+	}
+	return spotv3.OpenedOrder{
+		OrderBase:           spotV3Base(v),
+		ExecQty:             decimal(v.filled),
+		CummulativeQuoteQty: decimal(v.value(v.filled, v.avg)),
+		AvgPrice:            decimal(v.avg),
+		UpdateTime:          transport.Timestamp(v.updated),
+		IsWorking:           working,
+	}
+}
@@ -0,0 +1,79 @@
+package fake
+
+import (
+	"github.com/ginarea/gobybit/iperpetual"
+	"github.com/ginarea/gobybit/spot"
+	"github.com/ginarea/gobybit/spotv3"
+)
+
+// Derivatives symbols: inverse perpetual BTCUSD and USDT perpetual BTCUSDT
+var derivativesSymbols = []iperpetual.SymbolInfo{
+	{
+		Name:           "BTCUSD",
+		Alias:          "BTCUSD",
+		Status:         iperpetual.Trading,
+		BaseCurrency:   "BTC",
+		QuoteCurrency:  "USD",
+		PriceScale:     2,
+		LeverageFilter: iperpetual.LeverageFilter{Min: 1, Max: 100, Step: 0.01},
+		PriceFilter:    iperpetual.PriceFilter{Min: 0.5, Max: 999999, TickSize: 0.5},
+		LotSizeFilter:  iperpetual.LotSizeFilter{MinTradingQty: 1, MaxTradingQty: 1000000, QtyStep: 1},
+	},
+	{
+		Name:           "BTCUSDT",
+		Alias:          "BTCUSDT",
+		Status:         iperpetual.Trading,
+		BaseCurrency:   "BTC",
+		QuoteCurrency:  "USDT",
+		PriceScale:     2,
+		LeverageFilter: iperpetual.LeverageFilter{Min: 1, Max: 100, Step: 0.01},
+		PriceFilter:    iperpetual.PriceFilter{Min: 0.5, Max: 999999, TickSize: 0.5},
+		LotSizeFilter:  iperpetual.LotSizeFilter{MinTradingQty: 0.001, MaxTradingQty: 100, QtyStep: 0.001},
+	},
+}
+
+var spotSymbol = spot.SymbolInfo{
+	Name:              "BTCUSDT",
+	Alias:             "BTCUSDT",
+	BaseCurrency:      "BTC",
+	QuoteCurrency:     "USDT",
+	BasePrecision:     "0.000001",
+	QuotePrecision:    "0.00000001",
+	MinTradeQuantity:  "0.000048",
+	MinTradeAmount:    "1",
+	MaxTradeQuantity:  "46",
+	MaxTradeAmount:    "938901",
+	MinPricePrecision: "0.01",
+	Category:          1,
+	ShowStatus:        true,
+}
+
+func (o *Server) derivativesSymbols(*request) result {
+	return ok(derivativesSymbols)
+}
+
+func (o *Server) spotSymbols(*request) result {
+	return ok([]spot.SymbolInfo{spotSymbol})
+}
+
+func (o *Server) spotV3Symbols(*request) result {
+	v := spotv3.SymbolInfo{
+		Name:              spotSymbol.Name,
+		Alias:             spotSymbol.Alias,
+		BaseCurrency:      spotSymbol.BaseCurrency,
+		QuoteCurrency:     spotSymbol.QuoteCurrency,
+		BasePrecision:     spotSymbol.BasePrecision,
+		QuotePrecision:    spotSymbol.QuotePrecision,
+		MinTradeQuantity:  spotSymbol.MinTradeQuantity,
+		MinTradeAmount:    spotSymbol.MinTradeAmount,
+		MaxTradeQuantity:  spotSymbol.MaxTradeQuantity,
+		MaxTradeAmount:    spotSymbol.MaxTradeAmount,
+		MinPricePrecision: spotSymbol.MinPricePrecision,
+		Category:          "1",
+		ShowStatus:        "1",
+		Innovation:        "0",
+	}
+	return ok(struct {
+		List []spotv3.SymbolInfo `json:"list"`
+	}{[]spotv3.SymbolInfo{v}})
+}
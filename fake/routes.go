@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (o *Server) initRoutes() {
+	// inverse perpetual
+	o.handle(http.MethodGet, "v2/public/time", apiV2, false, func(*request) result {
+		return ok(struct{}{})
+	})
+	o.handle(http.MethodGet, "v2/public/symbols", apiV2, false, o.derivativesSymbols)
+	o.handle(http.MethodGet, "v2/public/kline/list", apiV2, false, o.inverseKline)
+	o.handle(http.MethodPost, "v2/private/order/create", apiV2, true, o.inverseCreate)
+	o.handle(http.MethodPost, "v2/private/order/cancel", apiV2, true, o.inverseCancel)
+	o.handle(http.MethodPost, "v2/private/order/cancelAll", apiV2, true, o.inverseCancelAll)
+	o.handle(http.MethodGet, "v2/private/order/list", apiV2, true, o.inverseList)
+	o.handle(http.MethodGet, "v2/private/order", apiV2, true, o.inverseQuery)
+	o.handle(http.MethodGet, "v2/private/position/list", apiV2, true, o.inversePosition)
+	o.handle(http.MethodGet, "v2/private/wallet/balance", apiV2, true, o.inverseWallet)
+
+	// usdt perpetual
+	o.handle(http.MethodPost, "private/linear/order/create", apiV2, true, o.linearCreate)
+	o.handle(http.MethodPost, "private/linear/order/cancel", apiV2, true, o.linearCancel)
+	o.handle(http.MethodPost, "private/linear/order/cancel-all", apiV2, true, o.linearCancelAll)
+	o.handle(http.MethodGet, "private/linear/order/list", apiV2, true, o.linearList)
+	o.handle(http.MethodGet, "private/linear/order/search", apiV2, true, o.linearQuery)
+	o.handle(http.MethodGet, "private/linear/position/list", apiV2, true, o.linearPosition)
+
+	// spot v1
+	o.handle(http.MethodGet, "spot/v1/time", apiSpot, false, func(*request) result {
+		return ok(struct {
+			Time int64 `json:"serverTime"`
+		}{time.Now().UnixMilli()})
+	})
+	o.handle(http.MethodGet, "spot/v1/symbols", apiSpot, false, o.spotSymbols)
+	o.handle(http.MethodPost, "spot/v1/order", apiSpot, true, o.spotCreate)
+	o.handle(http.MethodGet, "spot/v1/order", apiSpot, true, o.spotQuery)
+	o.handle(http.MethodDelete, "spot/v1/order", apiSpot, true, o.spotCancel)
+	o.handle(http.MethodGet, "spot/v1/open-orders", apiSpot, true, o.spotOpenOrders)
+	o.handle(http.MethodGet, "spot/v1/history-orders", apiSpot, true, o.spotHistory)
+
+	// spot v3
+	o.handle(http.MethodGet, "spot/v3/public/server-time", apiSpotV3, false, func(*request) result {
+		return ok(struct {
+			Time string `json:"serverTime"`
+		}{strconv.FormatInt(time.Now().UnixMilli(), 10)})
+	})
+	o.handle(http.MethodGet, "spot/v3/public/symbols", apiSpotV3, false, o.spotV3Symbols)
+	o.handle(http.MethodGet, "spot/v3/public/quote/kline", apiSpotV3, false, o.spotV3Kline)
+	o.handle(http.MethodPost, "spot/v3/private/order", apiSpotV3, true, o.spotV3Create)
+	o.handle(http.MethodGet, "spot/v3/private/order", apiSpotV3, true, o.spotV3Query)
+	o.handle(http.MethodPost, "spot/v3/private/cancel-order", apiSpotV3, true, o.spotV3Cancel)
+	o.handle(http.MethodGet, "spot/v3/private/open-orders", apiSpotV3, true, o.spotV3OpenOrders)
+	o.handle(http.MethodGet, "spot/v3/private/history-orders", apiSpotV3, true, o.spotV3History)
+
+	// account asset
+	o.handle(http.MethodPost, "asset/v1/private/transfer", apiAccount, true, o.transfer)
+	o.handle(http.MethodGet, "asset/v1/private/transfer/list", apiAccount, true, o.transferList)
+}
@@ -0,0 +1,344 @@
+// In-process fake Bybit exchange for offline integration testing
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ginarea/gobybit/account"
+	"github.com/ginarea/gobybit/iperpetual"
+	"github.com/ginarea/gobybit/spot"
+	"github.com/ginarea/gobybit/spotv3"
+	"github.com/ginarea/gobybit/transport"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	DefaultKey    = "fake-key"
+	DefaultSecret = "fake-secret"
+	DefaultPrice  = 20000
+
+	defaultRecvWindow = 5000 // ms
+)
+
+// Fake exchange: REST on URL, websocket streams on WsURL. Signed requests are checked against
+// the registered keys (HMAC only), orders are matched against prices set by SetPrice
+type Server struct {
+	URL   string
+	WsURL string
+
+	http      *httptest.Server
+	upgrader  websocket.Upgrader
+	routes    map[string]route
+	mutex     sync.Mutex
+	keys      map[string]string
+	prices    map[string]float64
+	orders    []*order
+	positions map[string]*position
+	transfers []account.InternalTransfer
+	sessions  map[*session]bool
+	seq       int
+}
+
+func NewServer() *Server {
+	o := &Server{
+		routes:    make(map[string]route),
+		keys:      map[string]string{DefaultKey: DefaultSecret},
+		prices:    make(map[string]float64),
+		positions: make(map[string]*position),
+		sessions:  make(map[*session]bool),
+	}
+	o.upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	o.initRoutes()
+	o.http = httptest.NewServer(o)
+	o.URL = o.http.URL
+	o.WsURL = "ws" + strings.TrimPrefix(o.http.URL, "http")
+	return o
+}
+
+func (o *Server) Close() {
+	o.mutex.Lock()
+	for s := range o.sessions {
+		s.conn.Close()
+	}
+	o.mutex.Unlock()
+	o.http.Close()
+}
+
+// Endpoints of the server for transport.SetDefaultProfile or WithProfile
+func (o *Server) Profile() transport.Profile {
+	return transport.NewProfile("fake", o.URL, o.WsURL)
+}
+
+func (o *Server) WithKey(key string, secret string) *Server {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.keys[key] = secret
+	return o
+}
+
+// Set the last price of the symbol and fill resting limit orders crossed by it
+func (o *Server) SetPrice(symbol string, price float64) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.prices[symbol] = price
+	for _, v := range o.orders {
+		if v.symbol == symbol && v.active() && v.crossed(price) {
+			o.fill(v, v.price)
+		}
+	}
+}
+
+func (o *Server) Price(symbol string) float64 {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.price(symbol)
+}
+
+func (o *Server) price(symbol string) float64 {
+	if p, ok := o.prices[symbol]; ok {
+		return p
+	}
+	return DefaultPrice
+}
+
+func (o *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if websocket.IsWebSocketUpgrade(r) {
+		o.serveWs(w, r, path)
+		return
+	}
+	rt, ok := o.routes[r.Method+" "+path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	req, err := newRequest(r)
+	if err != nil {
+		o.reply(w, rt.api, result{code: 10001, msg: err.Error()})
+		return
+	}
+	if rt.signed {
+		if res := o.verify(req); res.code != 0 {
+			o.reply(w, rt.api, res)
+			return
+		}
+	}
+	o.mutex.Lock()
+	res := rt.handler(req)
+	o.mutex.Unlock()
+	o.reply(w, rt.api, res)
+}
+
+type api int
+
+const (
+	apiV2 api = iota
+	apiSpot
+	apiSpotV3
+	apiAccount
+)
+
+type result struct {
+	code int
+	msg  string
+	data any
+}
+
+func ok(data any) result {
+	return result{data: data}
+}
+
+func fail(code int, msg string) result {
+	return result{code: code, msg: msg}
+}
+
+type route struct {
+	api     api
+	signed  bool
+	handler func(*request) result
+}
+
+func (o *Server) handle(method string, path string, a api, signed bool, handler func(*request) result) {
+	o.routes[method+" "+path] = route{api: a, signed: signed, handler: handler}
+}
+
+type request struct {
+	method string
+	params map[string]string
+	header http.Header
+	query  string
+	body   []byte
+}
+
+func newRequest(r *http.Request) (*request, error) {
+	req := &request{
+		method: r.Method,
+		params: make(map[string]string),
+		header: r.Header,
+		query:  r.URL.RawQuery,
+	}
+	for k, v := range r.URL.Query() {
+		if len(v) > 0 {
+			req.params[k] = v[0]
+		}
+	}
+	if r.ContentLength > 0 {
+		var err error
+		if req.body, err = io.ReadAll(r.Body); err != nil {
+			return nil, err
+		}
+		var m map[string]any
+		if err := json.Unmarshal(req.body, &m); err != nil {
+			return nil, fmt.Errorf("invalid json body: %v", err)
+		}
+		for k, v := range m {
+			req.params[k] = fmt.Sprint(v)
+		}
+	}
+	return req, nil
+}
+
+func (o *request) has(name string) bool {
+	_, ok := o.params[name]
+	return ok
+}
+
+func (o *request) str(name string) string {
+	return o.params[name]
+}
+
+func (o *request) float(name string) float64 {
+	v, _ := strconv.ParseFloat(o.params[name], 64)
+	return v
+}
+
+func (o *request) int(name string) int {
+	v, _ := strconv.Atoi(o.params[name])
+	return v
+}
+
+// Signature check for query/body signing (api_key, sign) and header signing (X-BAPI-*),
+// then timestamp check against recv_window.
+// Header signature is computed as documented for v3 (timestamp + api_key + recv_window + payload),
+// not the way the client does it, so signer bugs show up here
+func (o *Server) verify(r *request) result {
+	var key, sign, timestamp, recvWindow, signed string
+	if h := r.header.Get("X-BAPI-SIGN"); h != "" {
+		key = r.header.Get("X-BAPI-API-KEY")
+		sign = h
+		timestamp = r.header.Get("X-BAPI-TIMESTAMP")
+		recvWindow = r.header.Get("X-BAPI-RECV-WINDOW")
+		// Query string for GET, json body for POST, exactly as received
+		body := r.query
+		if r.method != http.MethodGet && len(r.body) > 0 {
+			body = string(r.body)
+		}
+		signed = timestamp + key + recvWindow + body
+	} else {
+		key = r.str("api_key")
+		sign = r.str("sign")
+		timestamp = r.str("timestamp")
+		recvWindow = r.str("recv_window")
+		m := make(map[string]string)
+		for k, v := range r.params {
+			if k != "sign" {
+				m[k] = v
+			}
+		}
+		signed = payload(m)
+	}
+	o.mutex.Lock()
+	secret, ok := o.keys[key]
+	o.mutex.Unlock()
+	if !ok {
+		return fail(10003, "invalid api_key")
+	}
+	expected, _ := transport.NewHmacSigner(secret).Sign([]byte(signed))
+	if sign != expected {
+		return fail(10004, "error sign")
+	}
+	return checkTimestamp(timestamp, recvWindow, time.Now())
+}
+
+// Bybit accepts server_time - recv_window <= timestamp < server_time + 1000
+func checkTimestamp(timestamp string, recvWindow string, now time.Time) result {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fail(10001, "invalid timestamp")
+	}
+	window := int64(defaultRecvWindow)
+	if recvWindow != "" {
+		if window, err = strconv.ParseInt(recvWindow, 10, 64); err != nil || window <= 0 {
+			return fail(10001, "invalid recv_window")
+		}
+	}
+	ms := now.UnixMilli()
+	if ts < ms-window || ts >= ms+1000 {
+		return fail(10002, fmt.Sprintf("invalid request, please check your timestamp and recv_window param. req_timestamp[%d],server_timestamp[%d],recv_window[%d]", ts, ms, window))
+	}
+	return result{}
+}
+
+func payload(m map[string]string) string {
+	v := make([]string, 0, len(m))
+	for k, s := range m {
+		v = append(v, k+"="+s)
+	}
+	sort.Strings(v)
+	return strings.Join(v, "&")
+}
+
+func (o *Server) reply(w http.ResponseWriter, a api, res result) {
+	now := time.Now()
+	var v any
+	switch a {
+	case apiV2:
+		v = iperpetual.Response[any]{
+			RetCode:          res.code,
+			RetMsg:           okMsg(res, "OK"),
+			Result:           res.data,
+			TimeNow:          fmt.Sprintf("%.6f", float64(now.UnixMicro())/1e6),
+			RateLimitStatus:  99,
+			RateLimitResetMs: now.UnixMilli(),
+			RateLimit:        100,
+		}
+	case apiSpot:
+		v = spot.Response[any]{
+			RetCode: res.code,
+			RetMsg:  okMsg(res, ""),
+			Result:  res.data,
+		}
+	case apiSpotV3:
+		v = spotv3.Response[any]{
+			RetCode: res.code,
+			RetMsg:  okMsg(res, "OK"),
+			Time:    transport.Timestamp(now),
+			Result:  res.data,
+		}
+	case apiAccount:
+		v = account.Response[any]{
+			RetCode: res.code,
+			RetMsg:  okMsg(res, "OK"),
+			Result:  res.data,
+			TimeNow: now.UnixMilli(),
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func okMsg(res result, msg string) string {
+	if res.code != 0 {
+		return res.msg
+	}
+	return msg
+}
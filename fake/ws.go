@@ -0,0 +1,396 @@
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ginarea/gobybit/iperpetual"
+	"github.com/ginarea/gobybit/spotv3"
+	"github.com/ginarea/gobybit/transport"
+	"github.com/ginarea/gobybit/uperpetual"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsInverse       = "realtime"
+	wsLinearPublic  = "realtime_public"
+	wsLinearPrivate = "realtime_private"
+	wsSpotPublic    = "spot/public/v3"
+	wsSpotPrivate   = "spot/private/v3"
+)
+
+type session struct {
+	path   string
+	id     string
+	conn   *websocket.Conn
+	mutex  sync.Mutex
+	authed bool
+	topics map[string]bool
+}
+
+func (o *session) spot() bool {
+	return strings.HasPrefix(o.path, "spot/")
+}
+
+func (o *session) write(v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+	o.conn.WriteMessage(websocket.TextMessage, b)
+}
+
+func (o *session) subscribed(topic string) bool {
+	for t := range o.topics {
+		if t == topic || strings.HasPrefix(t, topic+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *Server) serveWs(w http.ResponseWriter, r *http.Request, path string) {
+	switch path {
+	case wsInverse, wsLinearPublic, wsLinearPrivate, wsSpotPublic, wsSpotPrivate:
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	conn, err := o.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	o.mutex.Lock()
+	s := &session{
+		path:   path,
+		id:     o.nextID(),
+		conn:   conn,
+		topics: make(map[string]bool),
+	}
+	o.sessions[s] = true
+	o.mutex.Unlock()
+	defer func() {
+		o.mutex.Lock()
+		delete(o.sessions, s)
+		o.mutex.Unlock()
+		conn.Close()
+	}()
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		o.processWs(s, msg)
+	}
+}
+
+func (o *Server) processWs(s *session, msg []byte) {
+	var req struct {
+		Operation string `json:"op"`
+		Args      []any  `json:"args"`
+		ReqID     string `json:"req_id"`
+	}
+	d := json.NewDecoder(bytes.NewReader(msg))
+	d.UseNumber()
+	if err := d.Decode(&req); err != nil {
+		o.wsReply(s, req.Operation, nil, req.ReqID, false, "invalid request")
+		return
+	}
+	args := make([]string, len(req.Args))
+	for i, v := range req.Args {
+		args[i] = fmt.Sprint(v)
+	}
+	switch req.Operation {
+	case "ping":
+		o.wsReply(s, "pong", args, req.ReqID, true, "pong")
+	case "auth":
+		err := o.wsAuth(args)
+		if err == nil {
+			o.mutex.Lock()
+			s.authed = true
+			o.mutex.Unlock()
+		}
+		o.wsReply(s, req.Operation, args, req.ReqID, err == nil, errorText(err))
+	case "subscribe", "unsubscribe":
+		o.mutex.Lock()
+		for _, topic := range args {
+			if req.Operation == "subscribe" {
+				s.topics[topic] = true
+			} else {
+				delete(s.topics, topic)
+			}
+		}
+		o.mutex.Unlock()
+		o.wsReply(s, req.Operation, args, req.ReqID, true, "")
+	default:
+		o.wsReply(s, req.Operation, args, req.ReqID, false, "unknown op")
+	}
+}
+
+// Auth args: api key, expires (ms), signature of "GET/realtime{expires}"
+func (o *Server) wsAuth(args []string) error {
+	if len(args) != 3 {
+		return fmt.Errorf("invalid auth args")
+	}
+	o.mutex.Lock()
+	secret, ok := o.keys[args[0]]
+	o.mutex.Unlock()
+	if !ok {
+		return fmt.Errorf("invalid api_key")
+	}
+	expires, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil || expires < time.Now().UnixMilli() {
+		return fmt.Errorf("auth expired")
+	}
+	sign, _ := transport.NewHmacSigner(secret).Sign([]byte("GET/realtime" + args[1]))
+	if sign != args[2] {
+		return fmt.Errorf("error sign")
+	}
+	return nil
+}
+
+func errorText(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func (o *Server) wsReply(s *session, op string, args []string, reqID string, success bool, msg string) {
+	if s.spot() {
+		a := make([]any, len(args))
+		for i, v := range args {
+			a[i] = v
+		}
+		s.write(spotv3.Responce{
+			Operation: op,
+			Args:      a,
+			ReqID:     reqID,
+			ConnID:    s.id,
+			Success:   success,
+			RetMsg:    msg,
+		})
+		return
+	}
+	s.write(iperpetual.Responce{
+		Success: success,
+		RetMsg:  msg,
+		ConnID:  s.id,
+		Request: iperpetual.Request{Name: op, Args: args},
+	})
+}
+
+// Drops all websocket connections; clients reconnect, authorize and subscribe again
+func (o *Server) Disconnect() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	for s := range o.sessions {
+		s.conn.Close()
+	}
+}
+
+// Sends a public topic message (snapshot or delta) to sessions subscribed to the topic
+func (o *Server) Publish(topic string, v any) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	for s := range o.sessions {
+		if s.subscribed(topic) {
+			s.write(v)
+		}
+	}
+}
+
+// Number of sessions subscribed to the topic, to wait for clients before publishing
+func (o *Server) Subscribers(topic string) int {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	n := 0
+	for s := range o.sessions {
+		if s.subscribed(topic) {
+			n++
+		}
+	}
+	return n
+}
+
+// Send private topic to authorized sessions of the stream (caller holds the server mutex)
+func (o *Server) push(path string, topic string, v any) {
+	for s := range o.sessions {
+		if s.path == path && s.authed && s.subscribed(topic) {
+			s.write(v)
+		}
+	}
+}
+
+func (o *Server) pushOrder(v *order) {
+	switch v.market {
+	case marketInverse:
+		o.push(wsInverse, string(iperpetual.TopicOrder), iperpetual.Topic[[]iperpetual.OrderShot]{
+			Name: string(iperpetual.TopicOrder),
+			Data: []iperpetual.OrderShot{{
+				OrderID:       v.id,
+				OrderLinkID:   v.linkID,
+				Symbol:        v.symbol,
+				Side:          iperpetual.Side(v.side),
+				OrderType:     iperpetual.OrderType(v.kind),
+				Price:         decimal(v.price),
+				Qty:           decimal(v.qty),
+				TimeInForce:   iperpetual.TimeInForce(v.tif),
+				OrderStatus:   iperpetual.OrderStatus(v.statusName()),
+				LeavesQty:     decimal(v.leaves()),
+				CumExecQty:    decimal(v.filled),
+				CumExecValue:  decimal(v.value(v.filled, v.avg)),
+				Timestamp:     transport.Time(v.updated),
+				LastExecPrice: decimal(v.avg),
+			}},
+		})
+	case marketLinear:
+		o.push(wsLinearPrivate, string(uperpetual.TopicOrder), uperpetual.Topic[[]uperpetual.OrderSnapshot]{
+			Name: string(uperpetual.TopicOrder),
+			Data: []uperpetual.OrderSnapshot{{
+				OrderID:       v.id,
+				OrderLinkID:   v.linkID,
+				Symbol:        v.symbol,
+				Side:          uperpetual.Side(v.side),
+				OrderType:     uperpetual.OrderType(v.kind),
+				Price:         decimal(v.price),
+				Qty:           decimal(v.qty),
+				TimeInForce:   uperpetual.TimeInForce(v.tif),
+				OrderStatus:   uperpetual.OrderStatus(v.statusName()),
+				LeavesQty:     decimal(v.leaves()),
+				CumExecQty:    decimal(v.filled),
+				CumExecValue:  decimal(v.value(v.filled, v.avg)),
+				Timestamp:     transport.Time(v.updated),
+				LastExecPrice: decimal(v.avg),
+			}},
+		})
+	case marketSpotV3:
+		o.push(wsSpotPrivate, string(spotv3.TopicOrder), spotv3.Topic[[]spotv3.OrderSnapshot]{
+			Name:      string(spotv3.TopicOrder),
+			Type:      "snapshot",
+			Timestamp: transport.Timestamp(v.updated),
+			Data: []spotv3.OrderSnapshot{{
+				EventType:           "executionReport",
+				EventTime:           transport.Timestamp(v.updated),
+				Symbol:              v.symbol,
+				UserOrderID:         v.linkID,
+				Side:                strings.ToUpper(v.side),
+				OrderType:           v.kind,
+				TimeInForce:         v.tif,
+				Quantity:            decimal(v.qty),
+				Price:               decimal(v.price),
+				OrderStatus:         v.statusName(),
+				OrderID:             v.id,
+				TotalFilledQuantity: decimal(v.filled),
+				LastTradedPrice:     decimal(v.avg),
+				IsNormalTrade:       true,
+				IsWorking:           v.active(),
+				OrderCreationTime:   transport.Timestamp(v.created),
+				TotalFilledValue:    decimal(v.value(v.filled, v.avg)),
+				AccountID:           accountID,
+			}},
+		})
+	}
+}
+
+func (o *Server) pushExecution(v *order, qty float64, price float64) {
+	execID := o.nextID()
+	tradeTime := transport.Time(time.Now())
+	switch v.market {
+	case marketInverse:
+		o.push(wsInverse, string(iperpetual.TopicExecution), iperpetual.Topic[[]iperpetual.ExecutionShot]{
+			Name: string(iperpetual.TopicExecution),
+			Data: []iperpetual.ExecutionShot{{
+				OrderID:     v.id,
+				OrderLinkID: v.linkID,
+				Symbol:      v.symbol,
+				Side:        iperpetual.Side(v.side),
+				ExecID:      execID,
+				Price:       decimal(price),
+				OrderQty:    decimal(v.qty),
+				ExecType:    iperpetual.Trade,
+				ExecQty:     decimal(qty),
+				LeavesQty:   decimal(v.leaves()),
+				TradeTime:   tradeTime,
+			}},
+		})
+	case marketLinear:
+		o.push(wsLinearPrivate, string(uperpetual.TopicExecution), uperpetual.Topic[[]uperpetual.ExecutionSnapshot]{
+			Name: string(uperpetual.TopicExecution),
+			Data: []uperpetual.ExecutionSnapshot{{
+				OrderID:     v.id,
+				OrderLinkID: v.linkID,
+				Symbol:      v.symbol,
+				Side:        uperpetual.Side(v.side),
+				ExecID:      execID,
+				Price:       decimal(price),
+				OrderQty:    decimal(v.qty),
+				ExecType:    uperpetual.Trade,
+				ExecQty:     decimal(qty),
+				LeavesQty:   decimal(v.leaves()),
+				TradeTime:   tradeTime,
+			}},
+		})
+	case marketSpotV3:
+		o.push(wsSpotPrivate, string(spotv3.TopicTicket), spotv3.Topic[[]spotv3.TicketSnapshot]{
+			Name:      string(spotv3.TopicTicket),
+			Type:      "snapshot",
+			Timestamp: transport.Timestamp(time.Now()),
+			Data: []spotv3.TicketSnapshot{{
+				EventType: "ticketInfo",
+				EventTime: transport.Timestamp(time.Now()),
+				Symbol:    v.symbol,
+				Quantity:  decimal(qty),
+				Timestamp: transport.Timestamp(time.Now()),
+				Price:     decimal(price),
+				TradeID:   execID,
+				OrderID:   v.id,
+				AccountID: accountID,
+				Side:      strings.ToUpper(v.side),
+			}},
+		})
+	}
+}
+
+func (o *Server) pushPosition(p *position) {
+	switch p.market {
+	case marketInverse:
+		o.push(wsInverse, string(iperpetual.TopicPosition), iperpetual.Topic[[]iperpetual.PositionShot]{
+			Name: string(iperpetual.TopicPosition),
+			Data: []iperpetual.PositionShot{{
+				Symbol:         p.symbol,
+				Size:           int(p.abs()),
+				Side:           iperpetual.Side(p.side()),
+				EntryPrice:     decimal(p.entry),
+				Leverage:       1,
+				RealisedPnl:    transport.Float64(p.realised),
+				CumRealisedPnl: fmt.Sprint(p.realised),
+				PositionStatus: "Normal",
+			}},
+		})
+	case marketLinear:
+		o.push(wsLinearPrivate, string(uperpetual.TopicPosition), uperpetual.Topic[[]uperpetual.PositionSnapshot]{
+			Name: string(uperpetual.TopicPosition),
+			Data: []uperpetual.PositionSnapshot{{
+				Symbol:         p.symbol,
+				Size:           decimal(p.abs()),
+				Side:           uperpetual.Side(p.side()),
+				PositionValue:  fmt.Sprint(p.abs() * p.entry),
+				EntryPrice:     decimal(p.entry),
+				Leverage:       "1",
+				RealisedPnl:    fmt.Sprint(p.realised),
+				CumRealisedPnl: fmt.Sprint(p.realised),
+				PositionStatus: "Normal",
+			}},
+		})
+	}
+}
@@ -1,60 +1,72 @@
 // Conditional Orders (https://bybit-exchange.github.io/docs/futuresV2/inverse_futures/#t-conditionalorders)
 package ifutures
 
+import (
+	"context"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 type ConditionalOrderBase struct {
-	UserID      int          `json:"user_id"`
-	Symbol      string       `json:"symbol"`
-	Side        Side         `json:"side"`
-	OrderType   OrderType    `json:"order_type"`
-	Price       float64      `json:"price"`
-	Qty         int          `json:"qty"`
-	TimeInForce TimeInForce  `json:"time_in_force"`
-	TriggerBy   TriggerPrice `json:"trigger_by"`
-	StopPx      string       `json:"stop_px"`
-	BasePrice   string       `json:"base_price"`
-	CreatedAt   string       `json:"created_at"`
-	UpdatedAt   string       `json:"updated_at"`
+	UserID      int               `json:"user_id"`
+	Symbol      string            `json:"symbol"`
+	Side        Side              `json:"side"`
+	OrderType   OrderType         `json:"order_type"`
+	Price       transport.Decimal `json:"price"`
+	Qty         transport.Decimal `json:"qty"`
+	TimeInForce TimeInForce       `json:"time_in_force"`
+	TriggerBy   TriggerPrice      `json:"trigger_by"`
+	StopPx      transport.Decimal `json:"stop_px"`
+	BasePrice   transport.Decimal `json:"base_price"`
+	CreatedAt   transport.Time    `json:"created_at"`
+	UpdatedAt   transport.Time    `json:"updated_at"`
 }
 
 type ConditionalOrderProfitLoss struct {
-	TakeProfit float64      `json:"take_profit"`
-	StopLoss   float64      `json:"stop_loss"`
-	TpTrigger  TriggerPrice `json:"tp_trigger_by"`
-	SlTrigger  TriggerPrice `json:"sl_trigger_by"`
+	TakeProfit transport.Decimal `json:"take_profit"`
+	StopLoss   transport.Decimal `json:"stop_loss"`
+	TpTrigger  TriggerPrice      `json:"tp_trigger_by"`
+	SlTrigger  TriggerPrice      `json:"sl_trigger_by"`
 }
 
 // Place Conditional Order (https://bybit-exchange.github.io/docs/futuresV2/inverse_futures/#t-placecond)
 type PlaceConditionalOrder struct {
-	Side           Side          `param:"side"`
-	Symbol         string        `param:"symbol"`
-	OrderType      OrderType     `param:"order_type"`
-	Qty            int           `param:"qty"`
-	TimeInForce    TimeInForce   `param:"time_in_force"`
-	BasePrice      string        `param:"base_price"`
-	StopPx         string        `param:"stop_px"`
-	PositionIdx    *PositionIdx  `param:"position_idx"`
-	Price          *float64      `param:"price"`
-	CloseOnTrigger *bool         `param:"close_on_trigger"`
-	OrderLinkID    *string       `param:"order_link_id"`
-	TakeProfit     *float64      `param:"take_profit"`
-	StopLoss       *float64      `param:"stop_loss"`
-	TpTrigger      *TriggerPrice `param:"tp_trigger_by"`
-	SlTrigger      *TriggerPrice `param:"sl_trigger_by"`
-	TriggerBy      *TriggerPrice `param:"trigger_by"`
+	Side           Side               `param:"side"`
+	Symbol         string             `param:"symbol"`
+	OrderType      OrderType          `param:"order_type"`
+	Qty            transport.Decimal  `param:"qty"`
+	TimeInForce    TimeInForce        `param:"time_in_force"`
+	BasePrice      transport.Decimal  `param:"base_price"`
+	StopPx         transport.Decimal  `param:"stop_px"`
+	PositionIdx    *PositionIdx       `param:"position_idx"`
+	Price          *transport.Decimal `param:"price"`
+	CloseOnTrigger *bool              `param:"close_on_trigger"`
+	OrderLinkID    *string            `param:"order_link_id"`
+	TakeProfit     *transport.Decimal `param:"take_profit"`
+	StopLoss       *transport.Decimal `param:"stop_loss"`
+	TpTrigger      *TriggerPrice      `param:"tp_trigger_by"`
+	SlTrigger      *TriggerPrice      `param:"sl_trigger_by"`
+	TriggerBy      *TriggerPrice      `param:"trigger_by"`
 }
 
 type ConditionalOrderCreated struct {
 	ConditionalOrderProfitLoss
-	Remark       string  `json:"remark"`
-	RejectReason string  `json:"reject_reason"`
-	LeavesQty    float64 `json:"leaves_qty"`
-	LeavesValue  string  `json:"leaves_value"`
-	StopOrderID  string  `json:"stop_order_id"`
-	OrderLinkID  string  `json:"order_link_id"`
+	Remark       string            `json:"remark"`
+	RejectReason string            `json:"reject_reason"`
+	LeavesQty    transport.Decimal `json:"leaves_qty"`
+	LeavesValue  transport.Decimal `json:"leaves_value"`
+	StopOrderID  string            `json:"stop_order_id"`
+	OrderLinkID  string            `json:"order_link_id"`
 }
 
 func (this *PlaceConditionalOrder) Do(client *Client) (ConditionalOrderCreated, error) {
-	return Post[ConditionalOrderCreated](client, "stop-order/create", this)
+	// Rounded copy, the caller's order is left as is
+	order := *this
+	order.Price = transport.Clone(this.Price)
+	if err := client.prepareOrder(order.Symbol, &order.Qty, order.Price, &order.StopPx); err != nil {
+		return ConditionalOrderCreated{}, err
+	}
+	return Post[ConditionalOrderCreated](client, "stop-order/create", &order)
 }
 
 func (this *Client) PlaceConditionalOrder(v PlaceConditionalOrder) (ConditionalOrderCreated, error) {
@@ -66,6 +78,17 @@ func (this OrderList) DoConditional(client *Client) (ConditionalOrderListResult,
 	return Get[ConditionalOrderListResult](client, "stop-order/list", this)
 }
 
+// Iterator over all conditional orders, following the cursor
+func (this OrderList) IterConditional(client *Client) *transport.Pager[ConditionalOrderItem] {
+	return transport.NewPager(client.Context(), transport.Cursor(transport.Deref(this.Cursor), func(ctx context.Context, cursor string) ([]ConditionalOrderItem, string, error) {
+		if cursor != "" {
+			this.Cursor = &cursor
+		}
+		r, err := GetContext[ConditionalOrderListResult](ctx, client, "stop-order/list", this)
+		return r.Items, r.Cursor, err
+	}))
+}
+
 type ConditionalOrderListResult struct {
 	Items  []ConditionalOrderItem `json:"data"`
 	Cursor string                 `json:"cursor"`
@@ -104,16 +127,16 @@ func (this CancelAllOrders) DoConditional(client *Client) ([]ConditionalCancelOr
 
 type ConditionalCancelOrderItem struct {
 	ConditionalOrderProfitLoss
-	OrderID           string      `json:"clOrdID"`
-	CrossStatus       string      `json:"cross_status"`
-	CrossSeq          int         `json:"cross_seq"`
-	ExpectedDirection string      `json:"expected_direction"`
-	CreateType        CreateType  `json:"create_type"`
-	CancelType        CancelType  `json:"cancel_type"`
-	OrderStatus       OrderStatus `json:"order_status"`
-	LeavesQty         float64     `json:"leaves_qty"`
-	LeavesValue       string      `json:"leaves_value"`
-	StopOrderType     StopOrder   `json:"stop_order_type"`
+	OrderID           string            `json:"clOrdID"`
+	CrossStatus       string            `json:"cross_status"`
+	CrossSeq          int               `json:"cross_seq"`
+	ExpectedDirection string            `json:"expected_direction"`
+	CreateType        CreateType        `json:"create_type"`
+	CancelType        CancelType        `json:"cancel_type"`
+	OrderStatus       OrderStatus       `json:"order_status"`
+	LeavesQty         transport.Decimal `json:"leaves_qty"`
+	LeavesValue       transport.Decimal `json:"leaves_value"`
+	StopOrderType     StopOrder         `json:"stop_order_type"`
 }
 
 func (this *Client) CancelAllConditionalOrders(symbol string) ([]ConditionalCancelOrderItem, error) {
@@ -122,16 +145,16 @@ func (this *Client) CancelAllConditionalOrders(symbol string) ([]ConditionalCanc
 
 // Replace Conditional Order (https://bybit-exchange.github.io/docs/futuresV2/inverse_futures/#t-replacecond)
 type ReplaceConditionalOrder struct {
-	Symbol       string        `param:"symbol"`
-	OrderID      *string       `param:"stop_order_id"`
-	OrderLinkID  *string       `param:"order_link_id"`
-	Qty          *int          `param:"p_r_qty"`
-	Price        *string       `param:"p_r_price"`
-	TriggerPrice *string       `param:"p_r_trigger_price"`
-	TakeProfit   *float64      `param:"take_profit"`
-	StopLoss     *float64      `param:"stop_loss"`
-	TpTrigger    *TriggerPrice `param:"tp_trigger_by"`
-	SlTrigger    *TriggerPrice `param:"sl_trigger_by"`
+	Symbol       string             `param:"symbol"`
+	OrderID      *string            `param:"stop_order_id"`
+	OrderLinkID  *string            `param:"order_link_id"`
+	Qty          *transport.Decimal `param:"p_r_qty"`
+	Price        *transport.Decimal `param:"p_r_price"`
+	TriggerPrice *transport.Decimal `param:"p_r_trigger_price"`
+	TakeProfit   *transport.Decimal `param:"take_profit"`
+	StopLoss     *transport.Decimal `param:"stop_loss"`
+	TpTrigger    *TriggerPrice      `param:"tp_trigger_by"`
+	SlTrigger    *TriggerPrice      `param:"sl_trigger_by"`
 }
 
 func (this ReplaceConditionalOrder) Do(client *Client) (string, error) {
@@ -159,14 +182,14 @@ func (this QueryOrder) DoConditional(client *Client) ([]ConditionalOrder, error)
 
 type ConditionalOrder struct {
 	ConditionalOrderProfitLoss
-	CumExecQty   float64                   `json:"cum_exec_qty"`
-	CumExecValue float64                   `json:"cum_exec_value"`
-	CumExecFee   float64                   `json:"cum_exec_fee"`
+	CumExecQty   transport.Decimal         `json:"cum_exec_qty"`
+	CumExecValue transport.Decimal         `json:"cum_exec_value"`
+	CumExecFee   transport.Decimal         `json:"cum_exec_fee"`
 	OrderID      string                    `json:"order_id"`
 	RejectReason string                    `json:"reject_reason"`
 	OrderStatus  OrderStatus               `json:"order_status"`
-	LeavesQty    float64                   `json:"leaves_qty"`
-	LeavesValue  string                    `json:"leaves_value"`
+	LeavesQty    transport.Decimal         `json:"leaves_qty"`
+	LeavesValue  transport.Decimal         `json:"leaves_value"`
 	CancelType   CancelType                `json:"cancel_type"`
 	OrderLinkID  string                    `json:"order_link_id"`
 	PositionIdx  PositionIdx               `json:"position_idx"`
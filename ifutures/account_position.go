@@ -1,6 +1,12 @@
 // Position (https://bybit-exchange.github.io/docs/futuresV2/inverse_futures/#t-position)
 package ifutures
 
+import (
+	"context"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 // My Position (https://bybit-exchange.github.io/docs/futuresV2/inverse_futures/#t-myposition)
 type GetPosition struct {
 	Symbol *string `param:"symbol"`
@@ -11,37 +17,37 @@ func (this GetPosition) Do(client *Client) ([]PositionItem, error) {
 }
 
 type PositionBase struct {
-	ID                  int    `json:"id"`
-	UserID              int    `json:"user_id"`
-	RiskID              int    `json:"risk_id"`
-	Symbol              string `json:"symbol"`
-	Side                Side   `json:"side"`
-	Size                int    `json:"size"`
-	PositionValue       string `json:"position_value"`
-	EntryPrice          string `json:"entry_price"`
-	IsIsolated          bool   `json:"is_isolated"`
-	AutoAddMargin       int    `json:"auto_add_margin"`
-	Leverage            string `json:"leverage"`
-	EffectiveLeverage   string `json:"effective_leverage"`
-	PositionMargin      string `json:"position_margin"`
-	LiqPrice            string `json:"liq_price"`
-	BustPrice           string `json:"bust_price"`
-	OccClosingFee       string `json:"occ_closing_fee"`
-	OccFundingFee       string `json:"occ_funding_fee"`
-	TakeProfit          string `json:"take_profit"`
-	StopLoss            string `json:"stop_loss"`
-	TrailingStop        string `json:"trailing_stop"`
-	PositionStatus      string `json:"position_status"`
-	DeleverageIndicator int    `json:"deleverage_indicator"`
-	OcCalcData          string `json:"oc_calc_data"`
-	OrderMargin         string `json:"order_margin"`
-	WalletBalance       string `json:"wallet_balance"`
-	RealisedPnl         string `json:"realised_pnl"`
-	CumRealisedPnl      string `json:"cum_realised_pnl"`
-	CrossSeq            int    `json:"cross_seq"`
-	PositionSeq         int    `json:"position_seq"`
-	CreatedAt           string `json:"created_at"`
-	UpdatedAt           string `json:"updated_at"`
+	ID                  int            `json:"id"`
+	UserID              int            `json:"user_id"`
+	RiskID              int            `json:"risk_id"`
+	Symbol              string         `json:"symbol"`
+	Side                Side           `json:"side"`
+	Size                int            `json:"size"`
+	PositionValue       string         `json:"position_value"`
+	EntryPrice          string         `json:"entry_price"`
+	IsIsolated          bool           `json:"is_isolated"`
+	AutoAddMargin       int            `json:"auto_add_margin"`
+	Leverage            string         `json:"leverage"`
+	EffectiveLeverage   string         `json:"effective_leverage"`
+	PositionMargin      string         `json:"position_margin"`
+	LiqPrice            string         `json:"liq_price"`
+	BustPrice           string         `json:"bust_price"`
+	OccClosingFee       string         `json:"occ_closing_fee"`
+	OccFundingFee       string         `json:"occ_funding_fee"`
+	TakeProfit          string         `json:"take_profit"`
+	StopLoss            string         `json:"stop_loss"`
+	TrailingStop        string         `json:"trailing_stop"`
+	PositionStatus      string         `json:"position_status"`
+	DeleverageIndicator int            `json:"deleverage_indicator"`
+	OcCalcData          string         `json:"oc_calc_data"`
+	OrderMargin         string         `json:"order_margin"`
+	WalletBalance       string         `json:"wallet_balance"`
+	RealisedPnl         string         `json:"realised_pnl"`
+	CumRealisedPnl      string         `json:"cum_realised_pnl"`
+	CrossSeq            int            `json:"cross_seq"`
+	PositionSeq         int            `json:"position_seq"`
+	CreatedAt           transport.Time `json:"created_at"`
+	UpdatedAt           transport.Time `json:"updated_at"`
 }
 
 type PositionData struct {
@@ -119,6 +125,9 @@ type SetLeverage struct {
 }
 
 func (this SetLeverage) Do(client *Client) (int, error) {
+	if err := client.checkLeverage(this.Symbol, this.BuyLeverage, this.SellLeverage); err != nil {
+		return 0, err
+	}
 	return Post[int](client, "position/leverage/save", this)
 }
 
@@ -204,29 +213,38 @@ func (this GetTradeRecords) Do(client *Client) (TradeRecords, error) {
 	return Get[TradeRecords](client, "execution/list", this)
 }
 
+// Iterator over all trade records, page by page
+func (this GetTradeRecords) Iter(client *Client) *transport.Pager[TradeRecord] {
+	return transport.NewPager(client.Context(), transport.PageNumber(transport.Deref(this.Page), transport.Deref(this.Limit), func(ctx context.Context, page int) ([]TradeRecord, error) {
+		this.Page = &page
+		r, err := GetContext[TradeRecords](ctx, client, "execution/list", this)
+		return r.TradeRecords, err
+	}))
+}
+
 type TradeRecord struct {
-	ClosedSize    int       `json:"closed_size"`
-	CrossSeq      int       `json:"cross_seq"`
-	ExecFee       string    `json:"exec_fee"`
-	ExecID        string    `json:"exec_id"`
-	ExecPrice     string    `json:"exec_price"`
-	ExecQty       int       `json:"exec_qty"`
-	ExecTime      string    `json:"exec_time"`
-	ExecType      ExecType  `json:"exec_type"`
-	ExecValue     string    `json:"exec_value"`
-	FeeRate       string    `json:"fee_rate"`
-	LastLiquidity string    `json:"last_liquidity_ind"`
-	LeavesQty     int       `json:"leaves_qty"`
-	NthFill       int       `json:"nth_fill"`
-	OrderID       string    `json:"order_id"`
-	OrderLinkID   string    `json:"order_link_id"`
-	OrderPrice    string    `json:"order_price"`
-	OrderQty      int       `json:"order_qty"`
-	OrderType     OrderType `json:"order_type"`
-	Side          Side      `json:"side"`
-	Symbol        string    `json:"symbol"`
-	UserID        int       `json:"user_id"`
-	TradeTime     uint64    `json:"trade_time_ms"`
+	ClosedSize    int                 `json:"closed_size"`
+	CrossSeq      int                 `json:"cross_seq"`
+	ExecFee       string              `json:"exec_fee"`
+	ExecID        string              `json:"exec_id"`
+	ExecPrice     string              `json:"exec_price"`
+	ExecQty       int                 `json:"exec_qty"`
+	ExecTime      transport.Timestamp `json:"exec_time"`
+	ExecType      ExecType            `json:"exec_type"`
+	ExecValue     string              `json:"exec_value"`
+	FeeRate       string              `json:"fee_rate"`
+	LastLiquidity string              `json:"last_liquidity_ind"`
+	LeavesQty     int                 `json:"leaves_qty"`
+	NthFill       int                 `json:"nth_fill"`
+	OrderID       string              `json:"order_id"`
+	OrderLinkID   string              `json:"order_link_id"`
+	OrderPrice    string              `json:"order_price"`
+	OrderQty      int                 `json:"order_qty"`
+	OrderType     OrderType           `json:"order_type"`
+	Side          Side                `json:"side"`
+	Symbol        string              `json:"symbol"`
+	UserID        int                 `json:"user_id"`
+	TradeTime     transport.Timestamp `json:"trade_time_ms"`
 }
 
 type TradeRecords struct {
@@ -252,25 +270,34 @@ func (this ClosedProfitLoss) Do(client *Client) (ClosedProfitLossResult, error)
 	return Get[ClosedProfitLossResult](client, "trade/closed-pnl/list", this)
 }
 
+// Iterator over all closed positions, page by page
+func (this ClosedProfitLoss) Iter(client *Client) *transport.Pager[ClosedData] {
+	return transport.NewPager(client.Context(), transport.PageNumber(transport.Deref(this.Page), transport.Deref(this.Limit), func(ctx context.Context, page int) ([]ClosedData, error) {
+		this.Page = &page
+		r, err := GetContext[ClosedProfitLossResult](ctx, client, "trade/closed-pnl/list", this)
+		return r.Data, err
+	}))
+}
+
 type ClosedData struct {
-	ID            int       `json:"id"`
-	UserID        int       `json:"user_id"`
-	Symbol        string    `json:"symbol"`
-	OrderID       string    `json:"order_id"`
-	Side          Side      `json:"side"`
-	Qty           float64   `json:"qty"`
-	OrderPrice    float64   `json:"order_price"`
-	OrderType     OrderType `json:"order_type"`
-	ExecType      ExecType  `json:"exec_type"`
-	ClosedSize    float64   `json:"closed_size"`
-	CumEntryValue float64   `json:"cum_entry_value"`
-	AvgEntryPrice float64   `json:"avg_entry_price"`
-	CumExitValue  float64   `json:"cum_exit_value"`
-	AvgExitPrice  float64   `json:"avg_exit_price"`
-	ClosedPnl     float64   `json:"closed_pnl"`
-	FillCount     int       `json:"fill_count"`
-	Leverage      int       `json:"leverage"`
-	CreatedAt     uint64    `json:"created_at"`
+	ID            int                 `json:"id"`
+	UserID        int                 `json:"user_id"`
+	Symbol        string              `json:"symbol"`
+	OrderID       string              `json:"order_id"`
+	Side          Side                `json:"side"`
+	Qty           float64             `json:"qty"`
+	OrderPrice    float64             `json:"order_price"`
+	OrderType     OrderType           `json:"order_type"`
+	ExecType      ExecType            `json:"exec_type"`
+	ClosedSize    float64             `json:"closed_size"`
+	CumEntryValue float64             `json:"cum_entry_value"`
+	AvgEntryPrice float64             `json:"avg_entry_price"`
+	CumExitValue  float64             `json:"cum_exit_value"`
+	AvgExitPrice  float64             `json:"avg_exit_price"`
+	ClosedPnl     float64             `json:"closed_pnl"`
+	FillCount     int                 `json:"fill_count"`
+	Leverage      int                 `json:"leverage"`
+	CreatedAt     transport.Timestamp `json:"created_at"`
 }
 
 type ClosedProfitLossResult struct {
@@ -2,6 +2,7 @@
 package ifutures
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ginarea/gobybit/iperpetual"
@@ -10,41 +11,90 @@ import (
 
 // Inverse Futures HTTP client
 type Client struct {
-	c *transport.Client
+	c           *transport.Client
+	ctx         context.Context
+	instruments *transport.Instruments
 }
 
 func NewClient(client *transport.Client) *Client {
 	return &Client{c: client}
 }
 
+// Copy of the client which binds every request (including Do methods) to ctx
+func (this *Client) WithContext(ctx context.Context) *Client {
+	return &Client{c: this.c, ctx: ctx, instruments: this.instruments}
+}
+
+// Copy of the client which rounds and validates orders by symbol rules before sending
+func (this *Client) WithInstruments(instruments *transport.Instruments) *Client {
+	return &Client{c: this.c, ctx: this.ctx, instruments: instruments}
+}
+
+func (this *Client) Instruments() *transport.Instruments {
+	return this.instruments
+}
+
+func (this *Client) Context() context.Context {
+	if this.ctx == nil {
+		return context.Background()
+	}
+	return this.ctx
+}
+
 func (this *Client) Transport() *transport.Client {
 	return this.c
 }
 
 func (this *Client) Get(path string, param any, ret any) error {
-	return forwardError(this.c.Get(this.url(path), param, ret))
+	return this.GetContext(this.Context(), path, param, ret)
 }
 
 func (this *Client) Post(path string, param any, ret any) error {
-	return forwardError(this.c.Post(this.url(path), param, ret))
+	return this.PostContext(this.Context(), path, param, ret)
+}
+
+func (this *Client) GetContext(ctx context.Context, path string, param any, ret any) error {
+	return forwardError(this.c.GetContext(ctx, this.url(path), param, ret))
+}
+
+func (this *Client) PostContext(ctx context.Context, path string, param any, ret any) error {
+	return forwardError(this.c.PostContext(ctx, this.url(path), param, ret))
 }
 
 func Get[T any](c *Client, path string, param any) (T, error) {
-	resp := &Response[T]{}
-	err := c.Get(path, param, resp)
-	return resp.Result, err
+	return GetContext[T](c.Context(), c, path, param)
 }
 
 func Post[T any](c *Client, path string, param any) (T, error) {
-	resp := &Response[T]{}
-	err := c.Post(path, param, resp)
+	return PostContext[T](c.Context(), c, path, param)
+}
+
+func GetContext[T any](ctx context.Context, c *Client, path string, param any) (T, error) {
+	resp, err := GetResponse[T](ctx, c, path, param)
+	return resp.Result, err
+}
+
+func PostContext[T any](ctx context.Context, c *Client, path string, param any) (T, error) {
+	resp, err := PostResponse[T](ctx, c, path, param)
 	return resp.Result, err
 }
 
+func GetResponse[T any](ctx context.Context, c *Client, path string, param any) (*Response[T], error) {
+	resp := &Response[T]{}
+	err := c.GetContext(ctx, path, param, resp)
+	return resp, err
+}
+
+func PostResponse[T any](ctx context.Context, c *Client, path string, param any) (*Response[T], error) {
+	resp := &Response[T]{}
+	err := c.PostContext(ctx, path, param, resp)
+	return resp, err
+}
+
 func (this *Client) url(path string) string {
 	return fmt.Sprintf("futures/private/%s", path)
 }
 
 func (this *Client) iperpetual() *iperpetual.Client {
-	return iperpetual.NewClient(this.c)
+	return iperpetual.NewClient(this.c).WithContext(this.Context())
 }
@@ -1,10 +1,20 @@
 package ifutures
 
+import "github.com/ginarea/gobybit/transport"
+
 type Response[T any] struct {
-	RetCode int    `json:"ret_code"`
-	RetMsg  string `json:"ret_msg"`
-	ExtCode string `json:"ext_code"`
-	ExtInfo string `json:"ext_info"`
-	Result  T      `json:"result"`
-	TimeNow string `json:"time_now"`
+	RetCode          int            `json:"ret_code"`
+	RetMsg           string         `json:"ret_msg"`
+	ExtCode          string         `json:"ext_code"`
+	ExtInfo          string         `json:"ext_info"`
+	Result           T              `json:"result"`
+	TimeNow          string         `json:"time_now"`
+	RateLimitStatus  int            `json:"rate_limit_status"`
+	RateLimitResetMs int64          `json:"rate_limit_reset_ms"`
+	RateLimit        int            `json:"rate_limit"`
+	Meta             transport.Meta `json:"-"`
+}
+
+func (this *Response[T]) SetMeta(meta transport.Meta) {
+	this.Meta = meta
 }
@@ -2,68 +2,74 @@
 package iperpetual
 
 import (
+	"context"
 	"errors"
-	"time"
 
 	"github.com/ginarea/gobybit/transport"
 )
 
 type OrderMain struct {
-	UserID      int         `json:"user_id"`
-	Symbol      string      `json:"symbol"`
-	Side        Side        `json:"side"`
-	OrderType   OrderType   `json:"order_type"`
-	Price       float64     `json:"price"`
-	Qty         int         `json:"qty"`
-	TimeInForce TimeInForce `json:"time_in_force"`
-	OrderStatus OrderStatus `json:"order_status"`
-	LeavesQty   float64     `json:"leaves_qty"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	UserID      int               `json:"user_id"`
+	Symbol      string            `json:"symbol"`
+	Side        Side              `json:"side"`
+	OrderType   OrderType         `json:"order_type"`
+	Price       transport.Decimal `json:"price"`
+	Qty         transport.Decimal `json:"qty"`
+	TimeInForce TimeInForce       `json:"time_in_force"`
+	OrderStatus OrderStatus       `json:"order_status"`
+	LeavesQty   transport.Decimal `json:"leaves_qty"`
+	CreatedAt   transport.Time    `json:"created_at"`
+	UpdatedAt   transport.Time    `json:"updated_at"`
 }
 
 type OrderBase struct {
 	OrderMain
-	OrderID      string  `json:"order_id"`
-	OrderLinkID  string  `json:"order_link_id"`
-	CumExecQty   float64 `json:"cum_exec_qty"`
-	CumExecValue float64 `json:"cum_exec_value"`
-	CumExecFee   float64 `json:"cum_exec_fee"`
-	RejectReason string  `json:"reject_reason"`
+	OrderID      string            `json:"order_id"`
+	OrderLinkID  string            `json:"order_link_id"`
+	CumExecQty   transport.Decimal `json:"cum_exec_qty"`
+	CumExecValue transport.Decimal `json:"cum_exec_value"`
+	CumExecFee   transport.Decimal `json:"cum_exec_fee"`
+	RejectReason string            `json:"reject_reason"`
 }
 type OrderProfitLoss struct {
-	TakeProfit float64      `json:"take_profit"`
-	StopLoss   float64      `json:"stop_loss"`
-	TpTrigger  TriggerPrice `json:"tp_trigger_by"`
-	SlTrigger  TriggerPrice `json:"sl_trigger_by"`
+	TakeProfit transport.Decimal `json:"take_profit"`
+	StopLoss   transport.Decimal `json:"stop_loss"`
+	TpTrigger  TriggerPrice      `json:"tp_trigger_by"`
+	SlTrigger  TriggerPrice      `json:"sl_trigger_by"`
 }
 
 // Place Active Order (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-placeactive)
 type PlaceActiveOrder struct {
-	Side           Side          `param:"side"`
-	Symbol         string        `param:"symbol"`
-	OrderType      OrderType     `param:"order_type"`
-	Qty            float64       `param:"qty"`
-	TimeInForce    TimeInForce   `param:"time_in_force"`
-	Price          *float64      `param:"price"`
-	CloseOnTrigger *bool         `param:"close_on_trigger"`
-	OrderLinkID    *string       `param:"order_link_id"`
-	TakeProfit     *float64      `param:"take_profit"`
-	StopLoss       *float64      `param:"stop_loss"`
-	TpTrigger      *TriggerPrice `param:"tp_trigger_by"`
-	SlTrigger      *TriggerPrice `param:"sl_trigger_by"`
-	ReduceOnly     *bool         `param:"reduce_only"`
+	Side           Side               `param:"side"`
+	Symbol         string             `param:"symbol"`
+	OrderType      OrderType          `param:"order_type"`
+	Qty            transport.Decimal  `param:"qty"`
+	TimeInForce    TimeInForce        `param:"time_in_force"`
+	Price          *transport.Decimal `param:"price"`
+	CloseOnTrigger *bool              `param:"close_on_trigger"`
+	OrderLinkID    *string            `param:"order_link_id"`
+	TakeProfit     *transport.Decimal `param:"take_profit"`
+	StopLoss       *transport.Decimal `param:"stop_loss"`
+	TpTrigger      *TriggerPrice      `param:"tp_trigger_by"`
+	SlTrigger      *TriggerPrice      `param:"sl_trigger_by"`
+	ReduceOnly     *bool              `param:"reduce_only"`
 }
 
 type OrderCreated struct {
 	OrderBase
 	OrderProfitLoss
-	LastExecTime  string            `json:"last_exec_time"`
-	LastExecPrice transport.Float64 `json:"last_exec_price"`
+	LastExecTime  transport.Timestamp `json:"last_exec_time"`
+	LastExecPrice transport.Decimal   `json:"last_exec_price"`
 }
 
 func (this *PlaceActiveOrder) Do(client *Client) (OrderCreated, error) {
-	return Post[OrderCreated](client, "order/create", this)
+	// Rounded copy, the caller's order is left as is
+	order := *this
+	order.Price = transport.Clone(this.Price)
+	if err := client.prepareOrder(order.Symbol, &order.Qty, order.Price); err != nil {
+		return OrderCreated{}, err
+	}
+	return Post[OrderCreated](client, "order/create", &order)
 }
 
 func (this *Client) PlaceActiveOrder(v PlaceActiveOrder) (OrderCreated, error) {
@@ -83,6 +89,17 @@ func (this OrderList) Do(client *Client) (OrderListResult, error) {
 	return Get[OrderListResult](client, "order/list", this)
 }
 
+// Iterator over all orders, following the cursor
+func (this OrderList) Iter(client *Client) *transport.Pager[OrderItem] {
+	return transport.NewPager(client.Context(), transport.Cursor(transport.Deref(this.Cursor), func(ctx context.Context, cursor string) ([]OrderItem, string, error) {
+		if cursor != "" {
+			this.Cursor = &cursor
+		}
+		r, err := GetContext[OrderListResult](ctx, client, "order/list", this)
+		return r.Items, r.Cursor, err
+	}))
+}
+
 type OrderListResult struct {
 	Items  []OrderItem `json:"data"`
 	Cursor string      `json:"cursor"`
@@ -91,8 +108,8 @@ type OrderListResult struct {
 type OrderItem struct {
 	OrderBase
 	OrderProfitLoss
-	LeavesValue string      `json:"leaves_value"`
-	PositionIdx PositionIdx `json:"position_idx"`
+	LeavesValue transport.Decimal `json:"leaves_value"`
+	PositionIdx PositionIdx       `json:"position_idx"`
 }
 
 func (this *Client) OrderList(v OrderList) (OrderListResult, error) {
@@ -129,13 +146,13 @@ func (this CancelAllOrders) Do(client *Client) ([]CancelOrderItem, error) {
 
 type CancelOrderItem struct {
 	OrderMain
-	OrderID     string      `json:"clOrdID"`
-	LeavesValue string      `json:"leaves_value"`
-	CreateType  CreateType  `json:"create_type"`
-	CancelType  CancelType  `json:"cancel_type"`
-	CrossStatus OrderStatus `json:"cross_status"`
-	CrossSeq    int         `json:"cross_seq"`
-	OrderLinkID string      `оыщт:"order_link_id"`
+	OrderID     string            `json:"clOrdID"`
+	LeavesValue transport.Decimal `json:"leaves_value"`
+	CreateType  CreateType        `json:"create_type"`
+	CancelType  CancelType        `json:"cancel_type"`
+	CrossStatus OrderStatus       `json:"cross_status"`
+	CrossSeq    int               `json:"cross_seq"`
+	OrderLinkID string            `оыщт:"order_link_id"`
 }
 
 func (this *Client) CancelAllOrders(symbol string) ([]CancelOrderItem, error) {
@@ -144,15 +161,15 @@ func (this *Client) CancelAllOrders(symbol string) ([]CancelOrderItem, error) {
 
 // Replace Active Order (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-replaceactive)
 type ReplaceOrder struct {
-	Symbol      string        `param:"symbol"`
-	OrderID     *string       `param:"order_id"`
-	OrderLinkID *string       `param:"order_link_id"`
-	Qty         *int          `param:"p_r_qty"`
-	Price       *string       `param:"p_r_price"`
-	TakeProfit  *float64      `param:"take_profit"`
-	StopLoss    *float64      `param:"stop_loss"`
-	TpTrigger   *TriggerPrice `param:"tp_trigger_by"`
-	SlTrigger   *TriggerPrice `param:"sl_trigger_by"`
+	Symbol      string             `param:"symbol"`
+	OrderID     *string            `param:"order_id"`
+	OrderLinkID *string            `param:"order_link_id"`
+	Qty         *transport.Decimal `param:"p_r_qty"`
+	Price       *transport.Decimal `param:"p_r_price"`
+	TakeProfit  *transport.Decimal `param:"take_profit"`
+	StopLoss    *transport.Decimal `param:"stop_loss"`
+	TpTrigger   *TriggerPrice      `param:"tp_trigger_by"`
+	SlTrigger   *TriggerPrice      `param:"sl_trigger_by"`
 }
 
 func (this ReplaceOrder) Do(client *Client) (string, error) {
@@ -192,10 +209,10 @@ func (this QueryOrder) Do(client *Client) ([]Order, error) {
 
 type Order struct {
 	OrderCancelled
-	LeavesValue string         `json:"leaves_value"`
-	PositionIdx PositionIdx    `json:"position_idx"`
-	CancelType  CancelType     `json:"cancel_type"`
-	ExtFields   OrderExtFields `json:"ext_fields"`
+	LeavesValue transport.Decimal `json:"leaves_value"`
+	PositionIdx PositionIdx       `json:"position_idx"`
+	CancelType  CancelType        `json:"cancel_type"`
+	ExtFields   OrderExtFields    `json:"ext_fields"`
 }
 
 type OrderExtFields struct {
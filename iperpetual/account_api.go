@@ -1,9 +1,7 @@
 // API Key Info (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-key)
 package iperpetual
 
-import (
-	"time"
-)
+import "github.com/ginarea/gobybit/transport"
 
 type GetKeyInfo struct {
 }
@@ -13,19 +11,19 @@ func (this GetKeyInfo) Do(client *Client) ([]KeyInfo, error) {
 }
 
 type KeyInfo struct {
-	ApiKey        string    `json:"api_key"`
-	Type          string    `json:"type"`
-	UserID        int       `json:"user_id"`
-	InviterID     int       `json:"inviter_id"`
-	Ips           []string  `json:"ips"`
-	Note          string    `json:"note"`
-	Permissions   []string  `json:"permissions"`
-	CreatedAt     time.Time `json:"created_at"`
-	ExpiredAt     time.Time `json:"expired_at"`
-	ReadOnly      bool      `json:"read_only"`
-	VipLevel      string    `json:"vip_level"`
-	MktMakerLevel string    `json:"mkt_maker_level"`
-	AffiliateID   int       `json:"affiliate_id"`
+	ApiKey        string         `json:"api_key"`
+	Type          string         `json:"type"`
+	UserID        int            `json:"user_id"`
+	InviterID     int            `json:"inviter_id"`
+	Ips           []string       `json:"ips"`
+	Note          string         `json:"note"`
+	Permissions   []string       `json:"permissions"`
+	CreatedAt     transport.Time `json:"created_at"`
+	ExpiredAt     transport.Time `json:"expired_at"`
+	ReadOnly      bool           `json:"read_only"`
+	VipLevel      string         `json:"vip_level"`
+	MktMakerLevel string         `json:"mkt_maker_level"`
+	AffiliateID   int            `json:"affiliate_id"`
 }
 
 func (this *Client) GetKeyInfo() ([]KeyInfo, error) {
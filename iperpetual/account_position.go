@@ -2,8 +2,8 @@
 package iperpetual
 
 import (
+	"context"
 	"errors"
-	"time"
 
 	"github.com/ginarea/gobybit/transport"
 )
@@ -51,8 +51,8 @@ type PositionBase struct {
 	CumRealisedPnl      transport.Float64 `json:"cum_realised_pnl"`
 	CrossSeq            int               `json:"cross_seq"`
 	PositionSeq         int               `json:"position_seq"`
-	CreatedAt           time.Time         `json:"created_at"`
-	UpdatedAt           time.Time         `json:"updated_at"`
+	CreatedAt           transport.Time    `json:"created_at"`
+	UpdatedAt           transport.Time    `json:"updated_at"`
 }
 
 type PositionData struct {
@@ -145,6 +145,9 @@ type SetLeverage struct {
 }
 
 func (this SetLeverage) Do(client *Client) (int, error) {
+	if err := client.checkLeverage(this.Symbol, this.Leverage); err != nil {
+		return 0, err
+	}
 	return Post[int](client, "position/leverage/save", this)
 }
 
@@ -207,29 +210,38 @@ func (this GetTradeRecords) Do(client *Client) (TradeRecords, error) {
 	return Get[TradeRecords](client, "execution/list", this)
 }
 
+// Iterator over all trade records, page by page
+func (this GetTradeRecords) Iter(client *Client) *transport.Pager[TradeRecord] {
+	return transport.NewPager(client.Context(), transport.PageNumber(transport.Deref(this.Page), transport.Deref(this.Limit), func(ctx context.Context, page int) ([]TradeRecord, error) {
+		this.Page = &page
+		r, err := GetContext[TradeRecords](ctx, client, "execution/list", this)
+		return r.TradeRecords, err
+	}))
+}
+
 type TradeRecord struct {
-	ClosedSize    int       `json:"closed_size"`
-	CrossSeq      int       `json:"cross_seq"`
-	ExecFee       string    `json:"exec_fee"`
-	ExecID        string    `json:"exec_id"`
-	ExecPrice     string    `json:"exec_price"`
-	ExecQty       int       `json:"exec_qty"`
-	ExecTime      int64     `json:"exec_time"`
-	ExecType      ExecType  `json:"exec_type"`
-	ExecValue     string    `json:"exec_value"`
-	FeeRate       string    `json:"fee_rate"`
-	LastLiquidity string    `json:"last_liquidity_ind"`
-	LeavesQty     int       `json:"leaves_qty"`
-	NthFill       int       `json:"nth_fill"`
-	OrderID       string    `json:"order_id"`
-	OrderLinkID   string    `json:"order_link_id"`
-	OrderPrice    string    `json:"order_price"`
-	OrderQty      int       `json:"order_qty"`
-	OrderType     OrderType `json:"order_type"`
-	Side          Side      `json:"side"`
-	Symbol        string    `json:"symbol"`
-	UserID        int       `json:"user_id"`
-	TradeTime     uint64    `json:"trade_time_ms"`
+	ClosedSize    int                 `json:"closed_size"`
+	CrossSeq      int                 `json:"cross_seq"`
+	ExecFee       string              `json:"exec_fee"`
+	ExecID        string              `json:"exec_id"`
+	ExecPrice     string              `json:"exec_price"`
+	ExecQty       int                 `json:"exec_qty"`
+	ExecTime      transport.Timestamp `json:"exec_time"`
+	ExecType      ExecType            `json:"exec_type"`
+	ExecValue     string              `json:"exec_value"`
+	FeeRate       string              `json:"fee_rate"`
+	LastLiquidity string              `json:"last_liquidity_ind"`
+	LeavesQty     int                 `json:"leaves_qty"`
+	NthFill       int                 `json:"nth_fill"`
+	OrderID       string              `json:"order_id"`
+	OrderLinkID   string              `json:"order_link_id"`
+	OrderPrice    string              `json:"order_price"`
+	OrderQty      int                 `json:"order_qty"`
+	OrderType     OrderType           `json:"order_type"`
+	Side          Side                `json:"side"`
+	Symbol        string              `json:"symbol"`
+	UserID        int                 `json:"user_id"`
+	TradeTime     transport.Timestamp `json:"trade_time_ms"`
 }
 
 type TradeRecords struct {
@@ -255,25 +267,34 @@ func (this ClosedProfitLoss) Do(client *Client) (ClosedProfitLossResult, error)
 	return Get[ClosedProfitLossResult](client, "trade/closed-pnl/list", this)
 }
 
+// Iterator over all closed positions, page by page
+func (this ClosedProfitLoss) Iter(client *Client) *transport.Pager[ClosedData] {
+	return transport.NewPager(client.Context(), transport.PageNumber(transport.Deref(this.Page), transport.Deref(this.Limit), func(ctx context.Context, page int) ([]ClosedData, error) {
+		this.Page = &page
+		r, err := GetContext[ClosedProfitLossResult](ctx, client, "trade/closed-pnl/list", this)
+		return r.Data, err
+	}))
+}
+
 type ClosedData struct {
-	ID            int       `json:"id"`
-	UserID        int       `json:"user_id"`
-	Symbol        string    `json:"symbol"`
-	OrderID       string    `json:"order_id"`
-	Side          Side      `json:"side"`
-	Qty           float64   `json:"qty"`
-	OrderPrice    float64   `json:"order_price"`
-	OrderType     OrderType `json:"order_type"`
-	ExecType      ExecType  `json:"exec_type"`
-	ClosedSize    float64   `json:"closed_size"`
-	CumEntryValue float64   `json:"cum_entry_value"`
-	AvgEntryPrice float64   `json:"avg_entry_price"`
-	CumExitValue  float64   `json:"cum_exit_value"`
-	AvgExitPrice  float64   `json:"avg_exit_price"`
-	ClosedPnl     float64   `json:"closed_pnl"`
-	FillCount     int       `json:"fill_count"`
-	Leverage      int       `json:"leverage"`
-	CreatedAt     uint64    `json:"created_at"`
+	ID            int                 `json:"id"`
+	UserID        int                 `json:"user_id"`
+	Symbol        string              `json:"symbol"`
+	OrderID       string              `json:"order_id"`
+	Side          Side                `json:"side"`
+	Qty           float64             `json:"qty"`
+	OrderPrice    float64             `json:"order_price"`
+	OrderType     OrderType           `json:"order_type"`
+	ExecType      ExecType            `json:"exec_type"`
+	ClosedSize    float64             `json:"closed_size"`
+	CumEntryValue float64             `json:"cum_entry_value"`
+	AvgEntryPrice float64             `json:"avg_entry_price"`
+	CumExitValue  float64             `json:"cum_exit_value"`
+	AvgExitPrice  float64             `json:"avg_exit_price"`
+	ClosedPnl     float64             `json:"closed_pnl"`
+	FillCount     int                 `json:"fill_count"`
+	Leverage      int                 `json:"leverage"`
+	CreatedAt     transport.Timestamp `json:"created_at"`
 }
 
 type ClosedProfitLossResult struct {
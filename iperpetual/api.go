@@ -1,6 +1,13 @@
 // API Data Endpoints (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-api)
 package iperpetual
 
+import (
+	"strconv"
+	"time"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 // Server Time (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-servertime)
 func (this *Client) ServerTime() (string, error) {
 	resp := &Response[struct{}]{}
@@ -8,15 +15,25 @@ func (this *Client) ServerTime() (string, error) {
 	return resp.TimeNow, err
 }
 
+func (this *Client) ServerTimeValue() (t time.Time, err error) {
+	s, err := this.ServerTime()
+	if err == nil {
+		var sec float64
+		sec, err = strconv.ParseFloat(s, 64)
+		t = time.UnixMicro(int64(sec * 1e6))
+	}
+	return
+}
+
 // Announcement (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-announcement)
 //
 // Get Bybit OpenAPI announcements in the last 30 days in reverse order.
 type Announcement struct {
-	ID        int    `json:"id"`
-	Title     string `json:"title"`
-	Linkg     string `json:"link"`
-	Summary   string `json:"summary"`
-	CreatedAt string `json:"created_at"`
+	ID        int            `json:"id"`
+	Title     string         `json:"title"`
+	Linkg     string         `json:"link"`
+	Summary   string         `json:"summary"`
+	CreatedAt transport.Time `json:"created_at"`
 }
 
 func (this *Client) Announcement() ([]Announcement, error) {
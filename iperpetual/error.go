@@ -44,10 +44,40 @@ func (o *Error) InsufficientBalance() bool {
 	return slices.Contains(codes, o.Code)
 }
 
+// Error codes (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-errors)
+var errorCodes = transport.CodeMap{
+	20001: transport.ErrOrderNotFound,       // Order not exists
+	20003: transport.ErrInvalidParam,        // Missing parameter side
+	20004: transport.ErrInvalidParam,        // Invalid parameter side
+	20005: transport.ErrInvalidParam,        // Missing parameter symbol
+	20006: transport.ErrInvalidParam,        // Invalid parameter symbol
+	20007: transport.ErrInvalidParam,        // Missing parameter order_type
+	20008: transport.ErrInvalidParam,        // Invalid parameter order_type
+	20009: transport.ErrInvalidParam,        // Missing parameter qty
+	20010: transport.ErrInvalidParam,        // qty must be greater than 0
+	20011: transport.ErrInvalidParam,        // qty must be an integer
+	20014: transport.ErrAuth,                // Invalid API key format
+	20015: transport.ErrAuth,                // Invalid API key or IP
+	30003: transport.ErrInvalidParam,        // qty must be more than the minimum allowed
+	30004: transport.ErrInvalidParam,        // qty must be less than the maximum allowed
+	30005: transport.ErrInvalidParam,        // Price exceeds maximum allowed
+	30007: transport.ErrInvalidParam,        // Price exceeds minimum allowed
+	30008: transport.ErrInvalidParam,        // Invalid order_type
+	30010: transport.ErrInsufficientBalance, // Insufficient wallet balance
+	30022: transport.ErrInsufficientBalance, // Estimated buy liq_price cannot be higher than current mark_price
+	30023: transport.ErrInsufficientBalance, // Estimated sell liq_price cannot be lower than current mark_price
+	30031: transport.ErrInsufficientBalance, // Insufficient available balance for order cost
+	30032: transport.ErrOrderNotFound,       // Order has been filled or cancelled
+	30037: transport.ErrOrderNotFound,       // Order already cancelled
+	30042: transport.ErrInsufficientBalance, // Insufficient wallet balance
+	30049: transport.ErrInsufficientBalance, // Insufficient available balance
+	30067: transport.ErrInsufficientBalance, // Insufficient available balance
+}
+
 func forwardError(err error) error {
 	var terr *transport.Error
 	if errors.As(err, &terr) {
-		return &Error{Err: terr.Err}
+		return &Error{Err: terr.Err.Classify(errorCodes)}
 	}
 	return err
 }
@@ -0,0 +1,52 @@
+package iperpetual
+
+import "github.com/ginarea/gobybit/transport"
+
+// Trading rules for order validation
+func (this SymbolInfo) Instrument() transport.Instrument {
+	return transport.Instrument{
+		Symbol:      this.Name,
+		TickSize:    transport.DecimalFromFloat(this.PriceFilter.TickSize.Value()),
+		MinPrice:    transport.DecimalFromFloat(this.PriceFilter.Min.Value()),
+		MaxPrice:    transport.DecimalFromFloat(this.PriceFilter.Max.Value()),
+		QtyStep:     transport.DecimalFromFloat(this.LotSizeFilter.QtyStep),
+		MinQty:      transport.DecimalFromFloat(this.LotSizeFilter.MinTradingQty),
+		MaxQty:      transport.DecimalFromFloat(this.LotSizeFilter.MaxTradingQty),
+		MinLeverage: transport.DecimalFromInt(int64(this.LeverageFilter.Min)),
+		MaxLeverage: transport.DecimalFromInt(int64(this.LeverageFilter.Max)),
+	}
+}
+
+// Registry of derivatives symbols (inverse perpetual, USDT perpetual, inverse futures) loaded by QuerySymbol
+func NewInstruments(client *Client) *transport.Instruments {
+	return transport.NewInstruments(func() ([]transport.Instrument, error) {
+		l, err := client.QuerySymbol()
+		if err != nil {
+			return nil, err
+		}
+		r := make([]transport.Instrument, len(l))
+		for i, v := range l {
+			r[i] = v.Instrument()
+		}
+		return r, nil
+	})
+}
+
+func (this *Client) prepareOrder(symbol string, qty *transport.Decimal, prices ...*transport.Decimal) error {
+	if this.instruments == nil {
+		return nil
+	}
+	return this.instruments.PrepareOrder(symbol, qty, prices...)
+}
+
+func (this *Client) checkLeverage(symbol string, leverage ...int) error {
+	if this.instruments == nil {
+		return nil
+	}
+	for _, v := range leverage {
+		if err := this.instruments.CheckLeverage(symbol, transport.DecimalFromInt(int64(v))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
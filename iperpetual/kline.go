@@ -0,0 +1,85 @@
+package iperpetual
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
+// Max bars per kline request
+const KlineLimit = 200
+
+// Fixed duration of the interval; zero for a month
+func (this KlineInterval) Duration() time.Duration {
+	switch this {
+	case Interval1d:
+		return 24 * time.Hour
+	case Interval1w:
+		return 7 * 24 * time.Hour
+	case Interval1M:
+		return 0
+	}
+	m, _ := strconv.Atoi(string(this))
+	return time.Duration(m) * time.Minute
+}
+
+func (this KlineItem) Bar() transport.Bar {
+	return transport.Bar{
+		Time:     this.OpenTime.Time(),
+		Open:     this.Open,
+		High:     this.High,
+		Low:      this.Low,
+		Close:    this.Close,
+		Volume:   this.Volume,
+		Turnover: this.Turnover,
+	}
+}
+
+func (this MarkKlineItem) Bar() transport.Bar {
+	return transport.Bar{
+		Time:  this.OpenTime.Time(),
+		Open:  this.Open,
+		High:  this.High,
+		Low:   this.Low,
+		Close: this.Close,
+	}
+}
+
+func (this IndexKlineItem) Bar() transport.Bar {
+	return transport.Bar{
+		Time:  this.OpenTime.Time(),
+		Open:  this.Open,
+		High:  this.High,
+		Low:   this.Low,
+		Close: this.Close,
+	}
+}
+
+// Downloader of bars of any range (From and Limit are set per page)
+func (this QueryKline) Download(client *Client) *transport.KlineDownloader {
+	return downloadKline(this, client, QueryKline.Do)
+}
+
+func (this QueryKline) DownloadMark(client *Client) *transport.KlineDownloader {
+	return downloadKline(this, client, QueryKline.DoMark)
+}
+
+func (this QueryKline) DownloadIndex(client *Client) *transport.KlineDownloader {
+	return downloadKline(this, client, QueryKline.DoIndex)
+}
+
+func (this QueryKline) DownloadPremium(client *Client) *transport.KlineDownloader {
+	return downloadKline(this, client, QueryKline.DoPremium)
+}
+
+func downloadKline[T interface{ Bar() transport.Bar }](v QueryKline, client *Client, do func(QueryKline, *Client) ([]T, error)) *transport.KlineDownloader {
+	fetch := func(ctx context.Context, start time.Time, end time.Time, limit int) ([]transport.Bar, error) {
+		v.From = start.Unix()
+		v.Limit = &limit
+		l, err := do(v, client.WithContext(ctx))
+		return transport.Bars(l), err
+	}
+	return transport.NewKlineDownloader(client.Context(), v.Interval.Duration(), KlineLimit, fetch)
+}
@@ -93,15 +93,15 @@ func (this QueryKline) Do(client *Client) ([]KlineItem, error) {
 }
 
 type KlineItem struct {
-	Symbol   string        `json:"symbol"`
-	Interval KlineInterval `json:"interval"`
-	OpenTime uint64        `json:"open_time"`
-	Open     string        `json:"open"`
-	High     string        `json:"high"`
-	Low      string        `json:"low"`
-	Close    string        `json:"close"`
-	Volume   string        `json:"volume"`
-	Turnover string        `json:"turnover"`
+	Symbol   string              `json:"symbol"`
+	Interval KlineInterval       `json:"interval"`
+	OpenTime transport.Timestamp `json:"open_time"`
+	Open     transport.Decimal   `json:"open"`
+	High     transport.Decimal   `json:"high"`
+	Low      transport.Decimal   `json:"low"`
+	Close    transport.Decimal   `json:"close"`
+	Volume   transport.Decimal   `json:"volume"`
+	Turnover transport.Decimal   `json:"turnover"`
 }
 
 func (this *Client) QueryKline(v QueryKline) ([]KlineItem, error) {
@@ -143,7 +143,7 @@ type LatestInformation struct {
 	CountdownHour          int               `json:"countdown_hour"`
 	DeliveryFeeRate        string            `json:"delivery_fee_rate"`
 	PredictedDeliveryPrice string            `json:"predicted_delivery_price"`
-	DeliveryTime           string            `json:"delivery_time"`
+	DeliveryTime           transport.Time    `json:"delivery_time"`
 }
 
 func (this *Client) SymbolLatestInformation(symbol *string) ([]LatestInformation, error) {
@@ -176,12 +176,12 @@ func (this PublicTradingRecords) Do(client *Client) ([]PublicTradingRecord, erro
 }
 
 type PublicTradingRecord struct {
-	ID     int     `json:"id"`
-	Symbol string  `json:"symbol"`
-	Price  float64 `json:"price"`
-	Qty    int     `json:"qty"`
-	Side   Side    `json:"side"`
-	Time   string  `json:"time"`
+	ID     int            `json:"id"`
+	Symbol string         `json:"symbol"`
+	Price  float64        `json:"price"`
+	Qty    int            `json:"qty"`
+	Side   Side           `json:"side"`
+	Time   transport.Time `json:"time"`
 }
 
 func (this *Client) PublicTradingRecords(v PublicTradingRecords) ([]PublicTradingRecord, error) {
@@ -196,13 +196,13 @@ func (this QueryKline) DoMark(client *Client) ([]MarkKlineItem, error) {
 }
 
 type MarkKlineItem struct {
-	Symbol   string        `json:"symbol"`
-	Interval KlineInterval `json:"period"`
-	OpenTime uint64        `json:"start_at"`
-	Open     int           `json:"open"`
-	High     int           `json:"high"`
-	Low      int           `json:"low"`
-	Close    int           `json:"close"`
+	Symbol   string              `json:"symbol"`
+	Interval KlineInterval       `json:"period"`
+	OpenTime transport.Timestamp `json:"start_at"`
+	Open     transport.Decimal   `json:"open"`
+	High     transport.Decimal   `json:"high"`
+	Low      transport.Decimal   `json:"low"`
+	Close    transport.Decimal   `json:"close"`
 }
 
 func (this *Client) QueryMarkKline(v QueryKline) ([]MarkKlineItem, error) {
@@ -217,13 +217,13 @@ func (this QueryKline) DoIndex(client *Client) ([]IndexKlineItem, error) {
 }
 
 type IndexKlineItem struct {
-	Symbol   string        `json:"symbol"`
-	Interval KlineInterval `json:"period"`
-	OpenTime uint64        `json:"open_time"`
-	Open     string        `json:"open"`
-	High     string        `json:"high"`
-	Low      string        `json:"low"`
-	Close    string        `json:"close"`
+	Symbol   string              `json:"symbol"`
+	Interval KlineInterval       `json:"period"`
+	OpenTime transport.Timestamp `json:"open_time"`
+	Open     transport.Decimal   `json:"open"`
+	High     transport.Decimal   `json:"high"`
+	Low      transport.Decimal   `json:"low"`
+	Close    transport.Decimal   `json:"close"`
 }
 
 func (this *Client) QueryIndexKline(v QueryKline) ([]IndexKlineItem, error) {
@@ -1,6 +1,8 @@
 // Advanced Data (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-advanceddata)
 package iperpetual
 
+import "github.com/ginarea/gobybit/transport"
+
 // Open Interest (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-marketopeninterest)
 //
 // Gets the total amount of unsettled contracts. In other words, the total number of contracts held in open positions.
@@ -19,9 +21,9 @@ func (this OpenInterest) Do(client *Client) ([]InterestItem, error) {
 }
 
 type InterestItem struct {
-	Symbol       string `json:"symbol"`
-	Timestamp    uint64 `json:"timestamp"`
-	OpenInterest uint64 `json:"open_interest"`
+	Symbol       string              `json:"symbol"`
+	Timestamp    transport.Timestamp `json:"timestamp"`
+	OpenInterest uint64              `json:"open_interest"`
 }
 
 func (this *Client) OpenInterest(v OpenInterest) ([]InterestItem, error) {
@@ -47,10 +49,10 @@ func (this LatestBigDeal) Do(client *Client) ([]LatestBigDealItem, error) {
 }
 
 type LatestBigDealItem struct {
-	Symbol    string  `json:"symbol"`
-	Side      Side    `json:"side"`
-	Timestamp uint64  `json:"timestamp"`
-	Value     float64 `json:"value"`
+	Symbol    string              `json:"symbol"`
+	Side      Side                `json:"side"`
+	Timestamp transport.Timestamp `json:"timestamp"`
+	Value     float64             `json:"value"`
 }
 
 func (this *Client) LatestBigDeal(v LatestBigDeal) ([]LatestBigDealItem, error) {
@@ -75,10 +77,10 @@ func (this LongShortRatio) Do(client *Client) ([]LongShortRatioItem, error) {
 }
 
 type LongShortRatioItem struct {
-	Symbol    string  `json:"symbol"`
-	BuyRatio  float64 `json:"buy_ratio"`
-	SellRatio float64 `json:"sell_ratio"`
-	Timestamp uint64  `json:"timestamp"`
+	Symbol    string              `json:"symbol"`
+	BuyRatio  float64             `json:"buy_ratio"`
+	SellRatio float64             `json:"sell_ratio"`
+	Timestamp transport.Timestamp `json:"timestamp"`
 }
 
 func (this *Client) LongShortRatio(v LongShortRatio) ([]LongShortRatioItem, error) {
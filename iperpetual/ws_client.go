@@ -2,6 +2,9 @@
 package iperpetual
 
 import (
+	"errors"
+	"sync/atomic"
+
 	"github.com/ginarea/gobybit/transport"
 	"github.com/msw-x/moon"
 	"github.com/msw-x/moon/ufmt"
@@ -13,14 +16,15 @@ type WsClient struct {
 	ws             *transport.WsClient
 	public         *WsPublic
 	private        *WsPrivate
-	ready          bool
+	ready          int32
 	onConnected    func()
 	onDisconnected func()
 	onAuth         func(bool)
+	onReady        func(bool)
 }
 
 func NewWsClient() *WsClient {
-	ws := transport.NewWsClient("wss://stream.bybit.com/realtime")
+	ws := transport.NewWsClient(transport.DefaultProfile().WsInverse)
 	c := &WsClient{
 		log: ulog.Empty(),
 		ws:  ws,
@@ -43,6 +47,15 @@ func (this *WsClient) WithLog(log *ulog.Log) *WsClient {
 	return this
 }
 
+func (this *WsClient) WithUrl(url string) *WsClient {
+	this.ws.WithUrl(url)
+	return this
+}
+
+func (this *WsClient) WithProfile(profile transport.Profile) *WsClient {
+	return this.WithUrl(profile.WsInverse)
+}
+
 func (this *WsClient) WithProxy(proxy string) *WsClient {
 	this.Conf().SetProxy(proxy)
 	return this
@@ -53,6 +66,11 @@ func (this *WsClient) WithAuth(key string, secret string) *WsClient {
 	return this
 }
 
+func (this *WsClient) WithAuthSigner(key string, signer transport.Signer) *WsClient {
+	this.private = NewWsPrivateSigner(this, key, signer)
+	return this
+}
+
 func (this *WsClient) SetOnConnected(onConnected func()) {
 	this.onConnected = onConnected
 }
@@ -65,6 +83,11 @@ func (this *WsClient) SetOnAuth(onAuth func(bool)) {
 	this.onAuth = onAuth
 }
 
+// Decode, protocol and unknown topic errors with the raw frame
+func (this *WsClient) SetOnError(onError func(*transport.WsError)) {
+	this.ws.SetOnError(onError)
+}
+
 func (this *WsClient) Public() *WsPublic {
 	return this.public
 }
@@ -82,20 +105,20 @@ func (this *WsClient) Run() {
 		if this.onConnected != nil {
 			this.onConnected()
 		}
-		if this.private == nil {
-			this.ready = true
-		} else {
+		if this.private != nil {
 			this.log.Info("auth")
 			this.private.auth()
 		}
-		this.public.subscribeAll()
+		// Private stream is ready after auth
+		this.public.subscribeAll(this.private == nil)
 	})
 	this.ws.SetOnDisconnected(func() {
-		this.ready = false
+		this.setReady(false)
 		if this.onDisconnected != nil {
 			this.onDisconnected()
 		}
 	})
+	this.ws.SetOnResubscribe(this.resubscribe)
 	this.ws.SetOnMessage(this.processMessage)
 	this.ws.Run()
 }
@@ -105,7 +128,26 @@ func (this *WsClient) Connected() bool {
 }
 
 func (this *WsClient) Ready() bool {
-	return this.ready
+	return atomic.LoadInt32(&this.ready) == 1
+}
+
+func (this *WsClient) setReady(ready bool) {
+	this.storeReady(ready)
+	this.notifyReady(ready)
+}
+
+func (this *WsClient) storeReady(ready bool) {
+	var v int32
+	if ready {
+		v = 1
+	}
+	atomic.StoreInt32(&this.ready, v)
+}
+
+func (this *WsClient) notifyReady(ready bool) {
+	if this.onReady != nil {
+		this.onReady(ready)
+	}
 }
 
 func (this *WsClient) send(cmd any) bool {
@@ -120,6 +162,11 @@ func (this *WsClient) subscribe(topic string) bool {
 	})
 }
 
+func (this *WsClient) resubscribe(topic string) {
+	this.unsubscribe(topic)
+	this.subscribe(topic)
+}
+
 func (this *WsClient) unsubscribe(topic string) bool {
 	this.log.Infof("unsubscribe: topic[%s]", topic)
 	return this.send(Request{
@@ -131,61 +178,79 @@ func (this *WsClient) unsubscribe(topic string) bool {
 func (this *WsClient) processMessage(name string, msg []byte) {
 	switch name {
 	case "success":
-		v := transport.JsonUnmarshal[Responce](msg)
-		this.processResponce(v)
+		v, err := transport.JsonUnmarshal[Responce](msg)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
+		this.processResponce(v, msg)
 	case "topic":
-		v := transport.JsonUnmarshal[struct {
+		v, err := transport.JsonUnmarshal[struct {
 			Name string `json:"topic"`
 			Type string `json:"type"`
 		}](msg)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
 		this.processTopic(TopicMessage{
 			Topic: v.Name,
 			Delta: v.Type == "delta",
 			Bin:   msg,
 		})
 	default:
-		this.log.Error("unknown message:", name)
+		this.reportError(transport.ErrWsProtocol, name, msg, errors.New("unknown message"))
 	}
 }
 
-func (this *WsClient) processResponce(r Responce) {
-	if !r.Success {
-		this.log.Error(r.RetMsg)
-		return
-	}
+func (this *WsClient) processResponce(r Responce, msg []byte) {
+	// A failed request carries the error text in ret_msg
 	name := r.RetMsg
-	if name == "" {
+	if name == "" || !r.Success {
 		name = r.Request.Name
 	}
+	if !r.Success && name != "auth" {
+		this.reportError(transport.ErrWsProtocol, name, msg, errors.New(r.RetMsg))
+		return
+	}
 	switch name {
 	case "pong":
 	case "auth":
 		this.log.Info("auth:", ufmt.SuccessFailure(r.Success))
+		this.ws.NotifyAuth(r.Success)
 		if this.onAuth != nil {
 			this.onAuth(r.Success)
 		}
+		if !r.Success {
+			this.reportError(transport.ErrWsProtocol, name, msg, errors.New(r.RetMsg))
+			return
+		}
 		if this.private != nil {
-			this.ready = true
-			this.private.subscribeAll()
+			this.private.subscribeAll(true)
 		}
 	case "subscribe":
 		this.log.Infof("topic%s subscribe: %s", r.Request.Args, ufmt.SuccessFailure(r.Success))
 	case "unsubscribe":
 		this.log.Infof("topic%s unsubscribe: %s", r.Request.Args, ufmt.SuccessFailure(r.Success))
 	default:
-		this.log.Error("unknown response:", name)
+		this.reportError(transport.ErrWsProtocol, name, msg, errors.New("unknown response"))
 	}
 }
 
 func (this *WsClient) processTopic(m TopicMessage) {
 	ok, err := this.public.processTopic(m)
 	if err == nil && this.private != nil && !ok {
-		_, err = this.private.processTopic(m)
+		ok, err = this.private.processTopic(m)
 	}
 	if err != nil {
-		this.log.Errorf("process topic[%s]: %v", m.Topic, err)
+		this.reportError(transport.ErrDecode, m.Topic, m.Bin, err)
+	} else if !ok {
+		this.reportError(transport.ErrUnknownTopic, m.Topic, m.Bin, nil)
 	}
-	return
+}
+
+func (this *WsClient) reportError(kind error, name string, msg []byte, err error) {
+	this.ws.ReportError(transport.NewWsError(kind, name, msg, err))
 }
 
 type Request struct {
@@ -1,6 +1,7 @@
 package iperpetual
 
 import (
+	"encoding/json"
 	"reflect"
 )
 
@@ -48,8 +49,17 @@ func wsDeltaSetValue(vs reflect.Value, name string, v any) {
 		if ok && name == label {
 			f := vs.Field(i)
 			vv := reflect.ValueOf(v)
-			if f.CanSet() && vv.CanConvert(f.Type()) {
+			if !f.CanSet() {
+				continue
+			}
+			if vv.CanConvert(f.Type()) {
 				f.Set(vv.Convert(f.Type()))
+			} else if b, err := json.Marshal(v); err == nil {
+				// Fields with custom decoding (decimals, times)
+				p := reflect.New(f.Type())
+				if json.Unmarshal(b, p.Interface()) == nil {
+					f.Set(p.Elem())
+				}
 			}
 		}
 	}
@@ -1,17 +1,19 @@
 package iperpetual
 
+import "github.com/ginarea/gobybit/transport"
+
 type WsExecutor[T any] struct {
-	section *WsSection
+	section wsSection
 	topic   string
 }
 
-func NewWsExecutor[T any](section *WsSection, subscription Subscription) *WsExecutor[T] {
+func NewWsExecutor[T any](section wsSection, subscription Subscription) *WsExecutor[T] {
 	e := &WsExecutor[T]{}
 	e.Init(section, subscription)
 	return e
 }
 
-func (this *WsExecutor[T]) Init(section *WsSection, subscription Subscription) {
+func (this *WsExecutor[T]) Init(section wsSection, subscription Subscription) {
 	this.section = section
 	this.topic = subscription.String()
 }
@@ -30,11 +32,18 @@ func (this *WsExecutor[T]) Instant() *WsInstant[T] {
 	return NewWsInstant[T](this)
 }
 
+// Values through a channel of size buffered values instead of a callback
+func (this *WsExecutor[T]) Chan(size int, overflow transport.WsOverflow) *transport.WsChan[T] {
+	c := transport.NewWsChan[T](size, overflow, this.Unsubscribe)
+	this.Subscribe(c.Push)
+	return c
+}
+
 type WsDeltaExecutor[T any] struct {
 	WsExecutor[T]
 }
 
-func NewWsDeltaExecutor[T any](section *WsSection, subscription Subscription) *WsDeltaExecutor[T] {
+func NewWsDeltaExecutor[T any](section wsSection, subscription Subscription) *WsDeltaExecutor[T] {
 	e := &WsDeltaExecutor[T]{}
 	e.Init(section, subscription)
 	return e
@@ -62,3 +71,12 @@ func (this *WsDeltaExecutor[T]) Subscribe(onShot func(T)) {
 func (this *WsDeltaExecutor[T]) Instant() *WsInstant[T] {
 	return NewWsInstant[T](this)
 }
+
+// Merged snapshots through a channel; each value is a copy
+func (this *WsDeltaExecutor[T]) Chan(size int, overflow transport.WsOverflow) *transport.WsChan[T] {
+	c := transport.NewWsChan[T](size, overflow, this.Unsubscribe)
+	this.Subscribe(func(v T) {
+		c.Push(transport.WsClone(v))
+	})
+	return c
+}
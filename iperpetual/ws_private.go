@@ -1,23 +1,25 @@
 package iperpetual
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
 	"time"
+
+	"github.com/ginarea/gobybit/transport"
 )
 
 type WsPrivate struct {
 	WsSection
 	key    string
-	secret string
+	signer transport.Signer
 }
 
 func NewWsPrivate(client *WsClient, key string, secret string) *WsPrivate {
+	return NewWsPrivateSigner(client, key, transport.NewHmacSigner(secret))
+}
+
+func NewWsPrivateSigner(client *WsClient, key string, signer transport.Signer) *WsPrivate {
 	c := &WsPrivate{
 		key:    key,
-		secret: secret,
+		signer: signer,
 	}
 	c.init(client)
 	return c
@@ -44,21 +46,10 @@ func (this WsPrivate) Wallet() *WsExecutor[[]WalletShot] {
 }
 
 func (this *WsPrivate) auth() {
-	expires := time.Now().Unix()*1000 + 10000
-	req := fmt.Sprintf("GET/realtime%d", expires)
-	sig := hmac.New(sha256.New, []byte(this.secret))
-	sig.Write([]byte(req))
-	signature := hex.EncodeToString(sig.Sum(nil))
-	cmd := struct {
-		Name string `json:"op"`
-		Args []any  `json:"args"`
-	}{
-		Name: "auth",
-		Args: []any{
-			this.key,
-			expires,
-			signature,
-		},
+	cmd, err := transport.WsAuth(this.key, this.signer, time.Now())
+	if err != nil {
+		this.ws.log.Error("auth:", err)
+		return
 	}
 	this.ws.send(cmd)
 }
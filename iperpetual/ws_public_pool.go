@@ -0,0 +1,200 @@
+package iperpetual
+
+import (
+	"sync"
+
+	"github.com/ginarea/gobybit/transport"
+	"github.com/msw-x/moon/app"
+	"github.com/msw-x/moon/ulog"
+)
+
+// Public streams over several connections with the executors of WsClient.Public(). Subscriptions
+// are spread by topic limit per connection and moved from a dropped connection to connected ones
+type WsPublicPool struct {
+	log           *ulog.Log
+	mutex         sync.Mutex
+	conns         []*WsClient
+	shards        *transport.WsShards
+	subscriptions map[string]SubscriptionFunc
+}
+
+func NewWsPublicPool(connections int, topicLimit int) *WsPublicPool {
+	p := &WsPublicPool{
+		log:           ulog.Empty(),
+		shards:        transport.NewWsShards(connections, topicLimit),
+		subscriptions: make(map[string]SubscriptionFunc),
+	}
+	for i := 0; i < connections; i++ {
+		i := i
+		c := NewWsClient()
+		c.onReady = func(ready bool) {
+			if ready {
+				app.Go(func() { p.rebalance(i) })
+			} else {
+				app.Go(func() { p.drop(i) })
+			}
+		}
+		p.conns = append(p.conns, c)
+	}
+	return p
+}
+
+func (this *WsPublicPool) Shutdown() {
+	for _, c := range this.conns {
+		c.Shutdown()
+	}
+}
+
+// Connections, to configure each of them; their connect and disconnect hooks are free to use
+func (this *WsPublicPool) Connections() []*WsClient {
+	return this.conns
+}
+
+func (this *WsPublicPool) WithLog(log *ulog.Log) *WsPublicPool {
+	this.log = log
+	for _, c := range this.conns {
+		c.WithLog(log)
+	}
+	return this
+}
+
+func (this *WsPublicPool) WithUrl(url string) *WsPublicPool {
+	for _, c := range this.conns {
+		c.WithUrl(url)
+	}
+	return this
+}
+
+func (this *WsPublicPool) WithProfile(profile transport.Profile) *WsPublicPool {
+	return this.WithUrl(profile.WsInverse)
+}
+
+func (this *WsPublicPool) WithProxy(proxy string) *WsPublicPool {
+	for _, c := range this.conns {
+		c.WithProxy(proxy)
+	}
+	return this
+}
+
+func (this *WsPublicPool) Run() {
+	for _, c := range this.conns {
+		c.Run()
+	}
+}
+
+// Some connection is ready
+func (this *WsPublicPool) Ready() bool {
+	for _, c := range this.conns {
+		if c.Ready() {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *WsPublicPool) SetOnError(onError func(*transport.WsError)) {
+	for _, c := range this.conns {
+		c.SetOnError(onError)
+	}
+}
+
+func (this *WsPublicPool) OrderBook25(symbol string) *WsDeltaExecutor[[]OrderBookShot] {
+	return NewWsDeltaExecutor[[]OrderBookShot](this, Subscription{Topic: TopicOrderBook25, Symbol: symbol})
+}
+
+func (this *WsPublicPool) OrderBook200(symbol string) *WsDeltaExecutor[[]OrderBookShot] {
+	return NewWsDeltaExecutor[[]OrderBookShot](this, Subscription{Topic: TopicOrderBook200, Interval: "100ms", Symbol: symbol})
+}
+
+func (this *WsPublicPool) Trade() *WsExecutor[[]TradeShot] {
+	return NewWsExecutor[[]TradeShot](this, Subscription{Topic: TopicTrade})
+}
+
+func (this *WsPublicPool) Insurance() *WsExecutor[[]InsuranceShot] {
+	return NewWsExecutor[[]InsuranceShot](this, Subscription{Topic: TopicInsurance})
+}
+
+func (this *WsPublicPool) Instrument(symbol string) *WsDeltaExecutor[InstrumentShot] {
+	return NewWsDeltaExecutor[InstrumentShot](this, Subscription{Topic: TopicInstrument, Interval: "100ms", Symbol: symbol})
+}
+
+func (this *WsPublicPool) Kline(symbol string, interval KlineInterval) *WsExecutor[[]KlineShot] {
+	return NewWsExecutor[[]KlineShot](this, Subscription{Topic: TopicKline, Interval: string(interval), Symbol: symbol})
+}
+
+func (this *WsPublicPool) Liquidation() *WsExecutor[LiquidationShot] {
+	return NewWsExecutor[LiquidationShot](this, Subscription{Topic: TopicLiquidation})
+}
+
+func (this *WsPublicPool) subscribe(topic string, f SubscriptionFunc) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.subscriptions[topic] = f
+	if i, ok := this.shards.Connection(topic); ok {
+		this.conns[i].public.subscribe(topic, f)
+		return
+	}
+	this.place(topic)
+}
+
+func (this *WsPublicPool) unsubscribe(topic string) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	delete(this.subscriptions, topic)
+	if i, ok := this.shards.Remove(topic); ok {
+		this.conns[i].public.unsubscribe(topic)
+		this.placeAll()
+	}
+}
+
+func (this *WsPublicPool) place(topic string) bool {
+	i, ok := this.shards.Place(topic, this.healthy)
+	if !ok {
+		this.log.Errorf("topic[%s]: all connections are full, pending", topic)
+		return false
+	}
+	this.conns[i].public.subscribe(topic, this.subscriptions[topic])
+	return true
+}
+
+// Connection is back: topics of dropped connections are moved to connected ones
+// and pending topics are placed
+func (this *WsPublicPool) rebalance(connected int) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	for i := range this.conns {
+		if i != connected {
+			this.evacuate(i)
+		}
+	}
+	this.placeAll()
+}
+
+func (this *WsPublicPool) placeAll() {
+	for topic := range this.subscriptions {
+		if _, ok := this.shards.Connection(topic); !ok && !this.place(topic) {
+			return
+		}
+	}
+}
+
+func (this *WsPublicPool) drop(connection int) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.evacuate(connection)
+}
+
+func (this *WsPublicPool) evacuate(connection int) {
+	if this.healthy(connection) {
+		return
+	}
+	for _, m := range this.shards.Evacuate(connection, this.healthy) {
+		this.log.Infof("topic[%s]: move from connection %d to %d", m.Topic, m.From, m.To)
+		this.conns[m.From].public.unsubscribe(m.Topic)
+		this.conns[m.To].public.subscribe(m.Topic, this.subscriptions[m.Topic])
+	}
+}
+
+func (this *WsPublicPool) healthy(connection int) bool {
+	return this.conns[connection].Ready()
+}
@@ -20,6 +20,7 @@ func (this *WsSection) subscribe(topic string, f SubscriptionFunc) {
 	defer this.mutex.Unlock()
 	if this.ws.Ready() {
 		this.ws.subscribe(topic)
+		this.ws.Conf().Watchdog.Watch(topic)
 	}
 	this.subscriptions[topic] = f
 }
@@ -31,13 +32,23 @@ func (this *WsSection) unsubscribe(topic string) {
 		this.ws.unsubscribe(topic)
 	}
 	delete(this.subscriptions, topic)
+	this.ws.Conf().Watchdog.Forget(topic)
 }
 
-func (this *WsSection) subscribeAll() {
+// Readiness is set under the lock, so a concurrent subscribe is sent
+// either here or by itself, never twice
+func (this *WsSection) subscribeAll(ready bool) {
 	this.mutex.Lock()
-	defer this.mutex.Unlock()
 	for topic, _ := range this.subscriptions {
 		this.ws.subscribe(topic)
+		this.ws.Conf().Watchdog.Watch(topic)
+	}
+	if ready {
+		this.ws.storeReady(true)
+	}
+	this.mutex.Unlock()
+	if ready {
+		this.ws.notifyReady(true)
 	}
 }
 
@@ -45,11 +56,18 @@ func (this *WsSection) processTopic(m TopicMessage) (ok bool, err error) {
 	f, _ := this.subscriptions[m.Topic]
 	ok = f != nil
 	if ok {
+		this.ws.Conf().Watchdog.Touch(m.Topic)
 		err = f(m.Bin, m.Delta)
 	}
 	return
 }
 
+// Subscriptions of executors: a section of one connection or a pool
+type wsSection interface {
+	subscribe(topic string, f SubscriptionFunc)
+	unsubscribe(topic string)
+}
+
 type SubscriptionFunc func(m []byte, delta bool) error
 
 type Subscriptions map[string]SubscriptionFunc
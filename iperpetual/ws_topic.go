@@ -1,10 +1,6 @@
 package iperpetual
 
-import (
-	"time"
-
-	"github.com/ginarea/gobybit/transport"
-)
+import "github.com/ginarea/gobybit/transport"
 
 type TopicName string
 
@@ -34,91 +30,91 @@ type Topic[T any] struct {
 }
 
 type OrderBookShot struct {
-	Price  string `json:"price"`
-	Symbol string `json:"symbol"`
-	ID     uint64 `json:"id"`
-	Side   Side   `json:"side"`
-	Size   int    `json:"size"`
+	Price  transport.Decimal `json:"price"`
+	Symbol string            `json:"symbol"`
+	ID     uint64            `json:"id"`
+	Side   Side              `json:"side"`
+	Size   int               `json:"size"`
 }
 
 type TradeShot struct {
-	Timestamp     string        `json:"timestamp"`
-	TradeTime     uint64        `json:"trade_time_ms"`
-	Symbol        string        `json:"symbol"`
-	Side          Side          `json:"side"`
-	Size          int           `json:"size"`
-	Price         float64       `json:"price"`
-	TickDirection TickDirection `json:"tick_direction"`
-	TradeID       string        `json:"trade_id"`
-	CrossSeq      uint64        `json:"cross_seq"`
+	Timestamp     transport.Time      `json:"timestamp"`
+	TradeTime     transport.Timestamp `json:"trade_time_ms"`
+	Symbol        string              `json:"symbol"`
+	Side          Side                `json:"side"`
+	Size          int                 `json:"size"`
+	Price         transport.Decimal   `json:"price"`
+	TickDirection TickDirection       `json:"tick_direction"`
+	TradeID       string              `json:"trade_id"`
+	CrossSeq      uint64              `json:"cross_seq"`
 }
 
 type InsuranceShot struct {
-	Currency      string `json:"currency"`
-	Timestamp     string `json:"timestamp"`
-	WalletBalance uint64 `json:"wallet_balance"`
+	Currency      string         `json:"currency"`
+	Timestamp     transport.Time `json:"timestamp"`
+	WalletBalance uint64         `json:"wallet_balance"`
 }
 
 type InstrumentShot struct {
-	ID                     uint64        `json:"id"`
-	Symbol                 string        `json:"symbol"`
-	LastPriceE4            int64         `json:"last_price_e4"`
-	LastPrice              string        `json:"last_price"`
-	Bid1PriceE4            int64         `json:"bid1_price_e4"`
-	Bid1Price              string        `json:"bid1_price"`
-	Ask1PriceE4            int64         `json:"ask1_price_e4"`
-	Ask1Price              string        `json:"ask1_price"`
-	LastTickDirection      TickDirection `json:"last_tick_direction"`
-	PrevPrice24hE4         int64         `json:"prev_price_24h_e4"`
-	PrevPrice24h           string        `json:"prev_price_24h"`
-	HighPrice24hE4         int64         `json:"high_price_24h_e4"`
-	HighPrice24h           string        `json:"high_price_24h"`
-	LowPrice24hE4          int64         `json:"low_price_24h_e4"`
-	LowPrice24h            string        `json:"low_price_24h"`
-	PrevPrice1hE4          int64         `json:"prev_price_1h_e4"`
-	PrevPrice1h            string        `json:"prev_price_1h"`
-	MarkPriceE4            int64         `json:"mark_price_e4"`
-	MarkPrice              string        `json:"mark_price"`
-	IndexPriceE4           int64         `json:"index_price_e4"`
-	IndexPrice             string        `json:"index_price"`
-	OpenInterest           int64         `json:"open_interest"`
-	OpenValueE8            int64         `json:"open_value_e8"`
-	TotalTurnoverE8        int64         `json:"total_turnover_e8"`
-	Turnover24hE8          int64         `json:"turnover_24h_e8"`
-	TotalVolume            int64         `json:"total_volume"`
-	Volume24h              int64         `json:"volume_24h"`
-	FundingRateE6          int64         `json:"funding_rate_e6"`
-	PredictedFundingRateE6 int64         `json:"predicted_funding_rate_e6"`
-	CrossSeq               uint64        `json:"cross_seq"`
-	CreatedAt              string        `json:"created_at"`
-	UpdatedAt              string        `json:"updated_at"`
-	NextFundingTime        string        `json:"next_funding_time"`
-	CountdownHour          uint64        `json:"countdown_hour"`
-	FundingRateInterval    uint64        `json:"funding_rate_interval"`
-	SettleTimeE9           uint64        `json:"settle_time_e9"`
-	DelistingStatus        string        `json:"delisting_status"`
+	ID                     uint64              `json:"id"`
+	Symbol                 string              `json:"symbol"`
+	LastPriceE4            int64               `json:"last_price_e4"`
+	LastPrice              transport.Decimal   `json:"last_price"`
+	Bid1PriceE4            int64               `json:"bid1_price_e4"`
+	Bid1Price              transport.Decimal   `json:"bid1_price"`
+	Ask1PriceE4            int64               `json:"ask1_price_e4"`
+	Ask1Price              transport.Decimal   `json:"ask1_price"`
+	LastTickDirection      TickDirection       `json:"last_tick_direction"`
+	PrevPrice24hE4         int64               `json:"prev_price_24h_e4"`
+	PrevPrice24h           transport.Decimal   `json:"prev_price_24h"`
+	HighPrice24hE4         int64               `json:"high_price_24h_e4"`
+	HighPrice24h           transport.Decimal   `json:"high_price_24h"`
+	LowPrice24hE4          int64               `json:"low_price_24h_e4"`
+	LowPrice24h            transport.Decimal   `json:"low_price_24h"`
+	PrevPrice1hE4          int64               `json:"prev_price_1h_e4"`
+	PrevPrice1h            transport.Decimal   `json:"prev_price_1h"`
+	MarkPriceE4            int64               `json:"mark_price_e4"`
+	MarkPrice              transport.Decimal   `json:"mark_price"`
+	IndexPriceE4           int64               `json:"index_price_e4"`
+	IndexPrice             transport.Decimal   `json:"index_price"`
+	OpenInterest           int64               `json:"open_interest"`
+	OpenValueE8            int64               `json:"open_value_e8"`
+	TotalTurnoverE8        int64               `json:"total_turnover_e8"`
+	Turnover24hE8          int64               `json:"turnover_24h_e8"`
+	TotalVolume            int64               `json:"total_volume"`
+	Volume24h              int64               `json:"volume_24h"`
+	FundingRateE6          int64               `json:"funding_rate_e6"`
+	PredictedFundingRateE6 int64               `json:"predicted_funding_rate_e6"`
+	CrossSeq               uint64              `json:"cross_seq"`
+	CreatedAt              transport.Time      `json:"created_at"`
+	UpdatedAt              transport.Time      `json:"updated_at"`
+	NextFundingTime        transport.Time      `json:"next_funding_time"`
+	CountdownHour          uint64              `json:"countdown_hour"`
+	FundingRateInterval    uint64              `json:"funding_rate_interval"`
+	SettleTimeE9           transport.Timestamp `json:"settle_time_e9"`
+	DelistingStatus        string              `json:"delisting_status"`
 }
 
 type KlineShot struct {
-	Start     uint64  `json:"start"`
-	End       uint64  `json:"end"`
-	Open      float64 `json:"open"`
-	Close     float64 `json:"close"`
-	High      float64 `json:"high"`
-	Low       float64 `json:"low"`
-	Volume    float64 `json:"volume"`
-	Turnover  float64 `json:"turnover"`
-	Confirm   bool    `json:"confirm"`
-	CrossSeq  float64 `json:"cross_seq"`
-	Timestamp uint64  `json:"timestamp"`
+	Start     transport.Timestamp `json:"start"`
+	End       transport.Timestamp `json:"end"`
+	Open      transport.Decimal   `json:"open"`
+	Close     transport.Decimal   `json:"close"`
+	High      transport.Decimal   `json:"high"`
+	Low       transport.Decimal   `json:"low"`
+	Volume    transport.Decimal   `json:"volume"`
+	Turnover  transport.Decimal   `json:"turnover"`
+	Confirm   bool                `json:"confirm"`
+	CrossSeq  float64             `json:"cross_seq"`
+	Timestamp transport.Timestamp `json:"timestamp"`
 }
 
 type LiquidationShot struct {
-	Symbol string `json:"symbol"`
-	Side   Side   `json:"side"`
-	Price  string `json:"price"`
-	Qty    string `json:"qty"`
-	Time   int64  `json:"time"`
+	Symbol string              `json:"symbol"`
+	Side   Side                `json:"side"`
+	Price  transport.Decimal   `json:"price"`
+	Qty    transport.Decimal   `json:"qty"`
+	Time   transport.Timestamp `json:"time"`
 }
 
 type PositionShot struct {
@@ -127,22 +123,22 @@ type PositionShot struct {
 	Size             int               `json:"size"`
 	Side             Side              `json:"side"`
 	PositionValue    transport.Float64 `json:"position_value"`
-	EntryPrice       transport.Float64 `json:"entry_price"`
-	LiqPrice         transport.Float64 `json:"liq_price"`
-	BustPrice        transport.Float64 `json:"bust_price"`
+	EntryPrice       transport.Decimal `json:"entry_price"`
+	LiqPrice         transport.Decimal `json:"liq_price"`
+	BustPrice        transport.Decimal `json:"bust_price"`
 	Leverage         transport.Float64 `json:"leverage"`
 	OrderMargin      string            `json:"order_margin"`
 	PositionMargin   string            `json:"position_margin"`
 	AvailableBalance transport.Float64 `json:"available_balance"`
-	TakeProfit       string            `json:"take_profit"`
-	StopLoss         string            `json:"stop_loss"`
+	TakeProfit       transport.Decimal `json:"take_profit"`
+	StopLoss         transport.Decimal `json:"stop_loss"`
 	RealisedPnl      transport.Float64 `json:"realised_pnl"`
 	TrailingStop     string            `json:"trailing_stop"`
 	TrailingActive   string            `json:"trailing_active"`
 	WalletBalance    transport.Float64 `json:"wallet_balance"`
 	RiskID           int               `json:"risk_id"`
-	OccClosingFee    string            `json:"occ_closing_fee"`
-	OccFundingFee    string            `json:"occ_funding_fee"`
+	OccClosingFee    transport.Decimal `json:"occ_closing_fee"`
+	OccFundingFee    transport.Decimal `json:"occ_funding_fee"`
 	AutoAddMargin    int               `json:"auto_add_margin"`
 	CumRealisedPnl   string            `json:"cum_realised_pnl"`
 	PositionStatus   string            `json:"position_status"`
@@ -158,19 +154,19 @@ type PositionShot struct {
 }
 
 type ExecutionShot struct {
-	OrderID     string   `json:"order_id"`
-	OrderLinkID string   `json:"order_link_id"`
-	Symbol      string   `json:"symbol"`
-	Side        Side     `json:"side"`
-	ExecID      string   `json:"exec_id"`
-	Price       string   `json:"price"`
-	OrderQty    float64  `json:"order_qty"`
-	ExecType    ExecType `json:"exec_type"`
-	ExecQty     int      `json:"exec_qty"`
-	ExecFee     string   `json:"exec_fee"`
-	LeavesQty   float64  `json:"leaves_qty"`
-	IsMaker     bool     `json:"is_maker"`
-	TradeTime   string   `json:"trade_time"`
+	OrderID     string            `json:"order_id"`
+	OrderLinkID string            `json:"order_link_id"`
+	Symbol      string            `json:"symbol"`
+	Side        Side              `json:"side"`
+	ExecID      string            `json:"exec_id"`
+	Price       transport.Decimal `json:"price"`
+	OrderQty    transport.Decimal `json:"order_qty"`
+	ExecType    ExecType          `json:"exec_type"`
+	ExecQty     transport.Decimal `json:"exec_qty"`
+	ExecFee     transport.Decimal `json:"exec_fee"`
+	LeavesQty   transport.Decimal `json:"leaves_qty"`
+	IsMaker     bool              `json:"is_maker"`
+	TradeTime   transport.Time    `json:"trade_time"`
 }
 
 type OrderShot struct {
@@ -179,45 +175,45 @@ type OrderShot struct {
 	Symbol         string            `json:"symbol"`
 	Side           Side              `json:"side"`
 	OrderType      OrderType         `json:"order_type"`
-	Price          float64           `json:"price"`
-	Qty            transport.Float64 `json:"qty"`
+	Price          transport.Decimal `json:"price"`
+	Qty            transport.Decimal `json:"qty"`
 	TimeInForce    TimeInForce       `json:"time_in_force"`
 	CreateType     CreateType        `json:"create_type"`
 	CancelType     CancelType        `json:"cancel_type"`
 	OrderStatus    OrderStatus       `json:"order_status"`
-	LeavesQty      float64           `json:"leaves_qty"`
-	CumExecQty     float64           `json:"cum_exec_qty"`
-	CumExecValue   transport.Float64 `json:"cum_exec_value"`
-	CumExecFee     transport.Float64 `json:"cum_exec_fee"`
-	Timestamp      time.Time         `json:"timestamp"`
-	TakeProfit     float64           `json:"take_profit"`
+	LeavesQty      transport.Decimal `json:"leaves_qty"`
+	CumExecQty     transport.Decimal `json:"cum_exec_qty"`
+	CumExecValue   transport.Decimal `json:"cum_exec_value"`
+	CumExecFee     transport.Decimal `json:"cum_exec_fee"`
+	Timestamp      transport.Time    `json:"timestamp"`
+	TakeProfit     transport.Decimal `json:"take_profit"`
 	TpTrigger      TriggerPrice      `json:"tp_trigger_by"`
 	SlTrigger      TriggerPrice      `json:"sl_trigger_by"`
-	StopLoss       float64           `json:"stop_loss"`
+	StopLoss       transport.Decimal `json:"stop_loss"`
 	TrailingStop   string            `json:"trailing_stop"`
-	LastExecPrice  transport.Float64 `json:"last_exec_price"`
+	LastExecPrice  transport.Decimal `json:"last_exec_price"`
 	ReduceOnly     bool              `json:"reduce_only"`
 	CloseOnTrigger bool              `json:"close_on_trigger"`
 }
 
 type StopOrderShot struct {
-	OrderID        string       `json:"order_id"`
-	OrderLinkID    string       `json:"order_link_id"`
-	UserID         int          `json:"user_id"`
-	Symbol         string       `json:"symbol"`
-	Side           Side         `json:"side"`
-	OrderType      OrderType    `json:"order_type"`
-	Price          float64      `json:"price"`
-	CreateType     CreateType   `json:"create_type"`
-	CancelType     CancelType   `json:"cancel_type"`
-	OrderStatus    OrderStatus  `json:"order_status"`
-	StopOrderType  StopOrder    `json:"stop_order_type"`
-	TriggerBy      TriggerPrice `json:"trigger_by"`
-	TriggerPrice   string       `json:"trigger_price"`
-	CloseOnTrigger bool         `json:"close_on_trigger"`
-	Timestamp      string       `json:"timestamp"`
-	TakeProfit     float64      `json:"take_profit"`
-	StopLoss       float64      `json:"stop_loss"`
+	OrderID        string            `json:"order_id"`
+	OrderLinkID    string            `json:"order_link_id"`
+	UserID         int               `json:"user_id"`
+	Symbol         string            `json:"symbol"`
+	Side           Side              `json:"side"`
+	OrderType      OrderType         `json:"order_type"`
+	Price          transport.Decimal `json:"price"`
+	CreateType     CreateType        `json:"create_type"`
+	CancelType     CancelType        `json:"cancel_type"`
+	OrderStatus    OrderStatus       `json:"order_status"`
+	StopOrderType  StopOrder         `json:"stop_order_type"`
+	TriggerBy      TriggerPrice      `json:"trigger_by"`
+	TriggerPrice   transport.Decimal `json:"trigger_price"`
+	CloseOnTrigger bool              `json:"close_on_trigger"`
+	Timestamp      transport.Time    `json:"timestamp"`
+	TakeProfit     transport.Decimal `json:"take_profit"`
+	StopLoss       transport.Decimal `json:"stop_loss"`
 }
 
 type WalletShot struct {
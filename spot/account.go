@@ -1,18 +1,24 @@
 // Account Data Endpoints (https://bybit-exchange.github.io/docs/spot/v1/#t-accountdata)
 package spot
 
+import (
+	"context"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 type OrderBase struct {
-	AccountID   string      `json:"accountId"`
-	OrderID     string      `json:"orderId"`
-	OrderLinkID string      `json:"orderLinkId"`
-	Symbol      string      `json:"symbol"`
-	SymbolName  string      `json:"symbolName"`
-	Price       string      `json:"price"`
-	OrigQty     string      `json:"origQty"`
-	ExecutedQty string      `json:"executedQty"`
-	OrderType   OrderType   `json:"type"`
-	OrderStatus OrderStatus `json:"status"`
-	TimeInForce TimeInForce `json:"timeInForce"`
+	AccountID   string            `json:"accountId"`
+	OrderID     string            `json:"orderId"`
+	OrderLinkID string            `json:"orderLinkId"`
+	Symbol      string            `json:"symbol"`
+	SymbolName  string            `json:"symbolName"`
+	Price       transport.Decimal `json:"price"`
+	OrigQty     transport.Decimal `json:"origQty"`
+	ExecutedQty transport.Decimal `json:"executedQty"`
+	OrderType   OrderType         `json:"type"`
+	OrderStatus OrderStatus       `json:"status"`
+	TimeInForce TimeInForce       `json:"timeInForce"`
 }
 
 // Place Active Order (https://bybit-exchange.github.io/docs/spot/v1/#t-placeactive)
@@ -28,22 +34,25 @@ type OrderBase struct {
 //	                     the price field is required
 //	orderLinkId          string User-generated order ID
 type PlaceOrder struct {
-	Symbol      string       `param:"symbol"`
-	Qty         int          `param:"qty"`
-	Side        Side         `param:"side"`
-	Type        OrderType    `param:"type"`
-	TimeInForce *TimeInForce `param:"timeInForce"`
-	Price       *Price       `param:"price"`
-	OrderLinkID *string      `param:"orderLinkId"`
+	Symbol      string             `param:"symbol"`
+	Qty         transport.Decimal  `param:"qty"`
+	Side        Side               `param:"side"`
+	Type        OrderType          `param:"type"`
+	TimeInForce *TimeInForce       `param:"timeInForce"`
+	Price       *transport.Decimal `param:"price"`
+	OrderLinkID *string            `param:"orderLinkId"`
 }
 
 func (this PlaceOrder) Do(client *Client) (OrderCreated, error) {
+	if err := this.prepare(client); err != nil {
+		return OrderCreated{}, err
+	}
 	return Post[OrderCreated](client, "order", this)
 }
 
 type OrderCreated struct {
 	OrderBase
-	TransactTime string `json:"transactTime"`
+	TransactTime transport.Timestamp `json:"transactTime"`
 }
 
 func (this *Client) PlaceOrder(v PlaceOrder) (OrderCreated, error) {
@@ -217,16 +226,29 @@ func (this OrderHistory) Do(client *Client) ([]OrderHistoryResult, error) {
 	return Get[[]OrderHistoryResult](client, "history-orders", this)
 }
 
+// Iterator over all orders from the newest, each page starts below the last order id
+func (this OrderHistory) Iter(client *Client) *transport.Pager[OrderHistoryResult] {
+	key := func(v OrderHistoryResult) string {
+		return v.OrderID
+	}
+	return transport.NewPager(client.Context(), transport.ByID(transport.Deref(this.OrderID), transport.Deref(this.Limit), key, func(ctx context.Context, id string) ([]OrderHistoryResult, error) {
+		if id != "" {
+			this.OrderID = &id
+		}
+		return GetContext[[]OrderHistoryResult](ctx, client, "history-orders", this)
+	}))
+}
+
 type OrderHistoryResult struct {
 	OrderBase
-	ExchangeId          string `json:"exchangeId"`
-	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
-	AvgPrice            string `json:"avgPrice"`
-	StopPrice           string `json:"stopPrice"`
-	IcebergQty          string `json:"icebergQty"`
-	Time                string `json:"time"`
-	UpdateTime          string `json:"updateTime"`
-	IsWorking           bool   `json:"isWorking"`
+	ExchangeId          string              `json:"exchangeId"`
+	CummulativeQuoteQty transport.Decimal   `json:"cummulativeQuoteQty"`
+	AvgPrice            transport.Decimal   `json:"avgPrice"`
+	StopPrice           transport.Decimal   `json:"stopPrice"`
+	IcebergQty          transport.Decimal   `json:"icebergQty"`
+	Time                transport.Timestamp `json:"time"`
+	UpdateTime          transport.Timestamp `json:"updateTime"`
+	IsWorking           bool                `json:"isWorking"`
 }
 
 func (this *Client) OrderHistory(v OrderHistory) ([]OrderHistoryResult, error) {
@@ -257,24 +279,24 @@ func (this TradeHistory) Do(client *Client) ([]Trade, error) {
 }
 
 type Trade struct {
-	ID              string   `json:"id"`
-	Symbol          string   `json:"symbol"`
-	SymbolName      string   `json:"symbolName"`
-	OrderID         string   `json:"orderId"`
-	TicketID        string   `json:"ticketId"`
-	MatchOrderID    string   `json:"matchOrderId"`
-	Price           string   `json:"price"`
-	Qty             string   `json:"qty"`
-	Commission      string   `json:"commission"`
-	CommissionAsset string   `json:"commissionAsset"`
-	Time            string   `json:"time"`
-	IsBuyer         bool     `json:"isBuyer"`
-	IsMaker         bool     `json:"isMaker"`
-	Fee             TradeFee `json:"fee"`
-	FeeTokenID      string   `json:"feeTokenId"`
-	FeeAmount       string   `json:"feeAmount"`
-	MakerRebate     string   `json:"makerRebate"`
-	ExecutionTime   string   `json:"executionTime"`
+	ID              string              `json:"id"`
+	Symbol          string              `json:"symbol"`
+	SymbolName      string              `json:"symbolName"`
+	OrderID         string              `json:"orderId"`
+	TicketID        string              `json:"ticketId"`
+	MatchOrderID    string              `json:"matchOrderId"`
+	Price           string              `json:"price"`
+	Qty             string              `json:"qty"`
+	Commission      string              `json:"commission"`
+	CommissionAsset string              `json:"commissionAsset"`
+	Time            transport.Timestamp `json:"time"`
+	IsBuyer         bool                `json:"isBuyer"`
+	IsMaker         bool                `json:"isMaker"`
+	Fee             TradeFee            `json:"fee"`
+	FeeTokenID      string              `json:"feeTokenId"`
+	FeeAmount       string              `json:"feeAmount"`
+	MakerRebate     string              `json:"makerRebate"`
+	ExecutionTime   transport.Timestamp `json:"executionTime"`
 }
 
 type TradeFee struct {
@@ -1,11 +1,17 @@
 // API Data Endpoints (https://bybit-exchange.github.io/docs/spot/v1/#t-api)
 package spot
 
+import (
+	"time"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 // Server Time (https://bybit-exchange.github.io/docs/spot/v1/#t-servertime)
-func (this *Client) ServerTime() (uint64, error) {
+func (this *Client) ServerTime() (time.Time, error) {
 	type result struct {
-		Time uint64 `json:"serverTime"`
+		Time transport.Timestamp `json:"serverTime"`
 	}
 	r, err := GetPublic[result](this, "time", nil)
-	return r.Time, err
+	return r.Time.Time(), err
 }
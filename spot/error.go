@@ -10,10 +10,75 @@ type Error struct {
 	transport.Err
 }
 
+// Error codes (https://bybit-exchange.github.io/docs/spot/v1/#t-errors)
+var errorCodes = transport.CodeMap{
+	-1000: transport.ErrServer,              // Unknown error
+	-1001: transport.ErrServer,              // Internal error
+	-1002: transport.ErrAuth,                // Unauthorized for the current operation
+	-1003: transport.ErrRateLimit,           // Too many requests
+	-1004: transport.ErrInvalidParam,        // Bad request
+	-1005: transport.ErrAuth,                // No permission
+	-1006: transport.ErrServer,              // Unexpected response
+	-1007: transport.ErrServer,              // Timeout waiting for response
+	-1014: transport.ErrInvalidParam,        // Unsupported order combination
+	-1015: transport.ErrRateLimit,           // Too many new orders
+	-1016: transport.ErrServer,              // Service shutting down
+	-1020: transport.ErrInvalidParam,        // Unsupported operation
+	-1021: transport.ErrAuth,                // Timestamp outside of recv_window
+	-1022: transport.ErrAuth,                // Invalid signature
+	-1100: transport.ErrInvalidParam,        // Illegal characters in parameter
+	-1101: transport.ErrInvalidParam,        // Too many parameters
+	-1102: transport.ErrInvalidParam,        // Mandatory parameter is empty or malformed
+	-1103: transport.ErrInvalidParam,        // Unknown parameter
+	-1104: transport.ErrInvalidParam,        // Not all sent parameters were read
+	-1105: transport.ErrInvalidParam,        // Parameter is empty
+	-1106: transport.ErrInvalidParam,        // Parameter sent when not required
+	-1111: transport.ErrInvalidParam,        // Precision is over the maximum
+	-1112: transport.ErrInvalidParam,        // No orders on book for symbol
+	-1114: transport.ErrInvalidParam,        // timeInForce sent when not required
+	-1115: transport.ErrInvalidParam,        // Invalid timeInForce
+	-1116: transport.ErrInvalidParam,        // Invalid orderType
+	-1117: transport.ErrInvalidParam,        // Invalid side
+	-1118: transport.ErrInvalidParam,        // New client order id was empty
+	-1119: transport.ErrInvalidParam,        // Original client order id was empty
+	-1120: transport.ErrInvalidParam,        // Invalid interval
+	-1121: transport.ErrInvalidParam,        // Invalid symbol
+	-1125: transport.ErrAuth,                // Invalid listen key
+	-1127: transport.ErrInvalidParam,        // Lookup interval is too big
+	-1128: transport.ErrInvalidParam,        // Invalid combination of optional parameters
+	-1130: transport.ErrInvalidParam,        // Invalid data sent for a parameter
+	-1131: transport.ErrInsufficientBalance, // Balance insufficient
+	-1132: transport.ErrInvalidParam,        // Order price exceeds the maximum allowed
+	-1133: transport.ErrInvalidParam,        // Order price is below the minimum allowed
+	-1134: transport.ErrInvalidParam,        // Order price decimal too long
+	-1135: transport.ErrInvalidParam,        // Order qty exceeds the maximum allowed
+	-1136: transport.ErrInvalidParam,        // Order qty is below the minimum allowed
+	-1137: transport.ErrInvalidParam,        // Invalid quantity
+	-1138: transport.ErrInvalidParam,        // Order price exceeds the permissible range
+	-1139: transport.ErrOrderNotFound,       // Order has been filled
+	-1140: transport.ErrInvalidParam,        // Order value is below the minimum allowed
+	-1141: transport.ErrInvalidParam,        // Duplicate clientOrderId
+	-1142: transport.ErrOrderNotFound,       // Order has been cancelled
+	-1143: transport.ErrOrderNotFound,       // Order not found on order book
+	-1144: transport.ErrInvalidParam,        // Order has been locked
+	-1145: transport.ErrInvalidParam,        // Order type does not support cancellation
+	-1146: transport.ErrServer,              // Order creation timeout
+	-1147: transport.ErrServer,              // Order cancellation timeout
+	-1149: transport.ErrServer,              // Create order failed
+	-1150: transport.ErrServer,              // Cancel order failed
+	-1151: transport.ErrInvalidParam,        // Trading pair is not open yet
+	-2010: transport.ErrInvalidParam,        // New order rejected
+	-2011: transport.ErrOrderNotFound,       // Cancel rejected
+	-2013: transport.ErrOrderNotFound,       // Order does not exist
+	-2014: transport.ErrAuth,                // API key format invalid
+	-2015: transport.ErrAuth,                // Invalid API key, IP or permissions
+	-2016: transport.ErrInvalidParam,        // No trading window for the symbol
+}
+
 func forwardError(err error) error {
 	var terr *transport.Error
 	if errors.As(err, &terr) {
-		return &Error{Err: terr.Err}
+		return &Error{Err: terr.Err.Classify(errorCodes)}
 	}
 	return err
 }
@@ -0,0 +1,50 @@
+package spot
+
+import "github.com/ginarea/gobybit/transport"
+
+// Trading rules for order validation
+func (this SymbolInfo) Instrument() transport.Instrument {
+	return transport.Instrument{
+		Symbol:      this.Name,
+		TickSize:    decimal(this.MinPricePrecision),
+		QtyStep:     decimal(this.BasePrecision),
+		MinQty:      decimal(this.MinTradeQuantity),
+		MaxQty:      decimal(this.MaxTradeQuantity),
+		QuoteStep:   decimal(this.QuotePrecision),
+		MinNotional: decimal(this.MinTradeAmount),
+		MaxNotional: decimal(this.MaxTradeAmount),
+	}
+}
+
+// Registry of spot v1 symbols loaded by QuerySymbol
+func NewInstruments(client *Client) *transport.Instruments {
+	return transport.NewInstruments(func() ([]transport.Instrument, error) {
+		l, err := client.QuerySymbol()
+		if err != nil {
+			return nil, err
+		}
+		r := make([]transport.Instrument, len(l))
+		for i, v := range l {
+			r[i] = v.Instrument()
+		}
+		return r, nil
+	})
+}
+
+// Market buy qty is an amount of quote currency
+func (this *PlaceOrder) prepare(client *Client) error {
+	if client.instruments == nil {
+		return nil
+	}
+	// The order is a copy, but prices are shared with the caller
+	this.Price = transport.Clone(this.Price)
+	if this.Type == Market && this.Side == Buy {
+		return client.instruments.PrepareQuote(this.Symbol, &this.Qty)
+	}
+	return client.instruments.PrepareOrder(this.Symbol, &this.Qty, this.Price)
+}
+
+func decimal(s string) transport.Decimal {
+	d, _ := transport.ParseDecimal(s)
+	return d
+}
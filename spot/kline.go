@@ -0,0 +1,69 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
+// Max bars per kline request
+const KlineLimit = 1000
+
+// Fixed duration of the interval; zero for a month
+func (this KlineInterval) Duration() time.Duration {
+	s := string(this)
+	if s == "" {
+		return 0
+	}
+	n, _ := strconv.Atoi(s[:len(s)-1])
+	switch s[len(s)-1] {
+	case 'm':
+		return time.Duration(n) * time.Minute
+	case 'h':
+		return time.Duration(n) * time.Hour
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour
+	}
+	return 0
+}
+
+// Bar of a kline row: start time, open, high, low, close, volume, end time, quote asset volume, ...
+func KlineBar(row []any) transport.Bar {
+	var v transport.Bar
+	if len(row) < 8 {
+		return v
+	}
+	if t, ok := row[0].(float64); ok {
+		v.Time = time.UnixMilli(int64(t))
+	}
+	v.Open = decimal(fmt.Sprint(row[1]))
+	v.High = decimal(fmt.Sprint(row[2]))
+	v.Low = decimal(fmt.Sprint(row[3]))
+	v.Close = decimal(fmt.Sprint(row[4]))
+	v.Volume = decimal(fmt.Sprint(row[5]))
+	v.Turnover = decimal(fmt.Sprint(row[7]))
+	return v
+}
+
+// Downloader of bars of any range (StartTime, EndTime and Limit are set per page)
+func (this QueryKline) Download(client *Client) *transport.KlineDownloader {
+	fetch := func(ctx context.Context, start time.Time, end time.Time, limit int) ([]transport.Bar, error) {
+		from := int(start.UnixMilli())
+		to := int(end.UnixMilli()) - 1
+		this.StartTime = &from
+		this.EndTime = &to
+		this.Limit = &limit
+		rows, err := this.Do(client.WithContext(ctx))
+		bars := make([]transport.Bar, len(rows))
+		for i, row := range rows {
+			bars[i] = KlineBar(row)
+		}
+		return bars, err
+	}
+	return transport.NewKlineDownloader(client.Context(), this.Interval.Duration(), KlineLimit, fetch)
+}
@@ -1,6 +1,8 @@
 // Market Data Endpoints (https://bybit-exchange.github.io/docs/spot/v1/#t-marketdata)
 package spot
 
+import "github.com/ginarea/gobybit/transport"
+
 // Query Symbol (https://bybit-exchange.github.io/docs/spot/v1/#t-spot_querysymbol)
 type SymbolInfo struct {
 	Name              string `json:"name"`
@@ -46,9 +48,9 @@ func (this OrderBook) Do(client *Client) (OrderBookResult, error) {
 }
 
 type OrderBookResult struct {
-	Time uint64 `json:"time"`
-	Bids [][]string
-	Asks [][]string
+	Time transport.Timestamp `json:"time"`
+	Bids [][]transport.Decimal
+	Asks [][]transport.Decimal
 }
 
 func (this *Client) OrderBook(v OrderBook) (OrderBookResult, error) {
@@ -88,10 +90,10 @@ func (this PublicTradingRecords) Do(client *Client) ([]PublicTradingRecord, erro
 }
 
 type PublicTradingRecord struct {
-	Price        string `json:"price"`
-	Time         uint64 `json:"time"`
-	Qty          string `json:"qty"`
-	IsBuyerMaker bool   `json:"isBuyerMaker"`
+	Price        transport.Decimal   `json:"price"`
+	Time         transport.Timestamp `json:"time"`
+	Qty          transport.Decimal   `json:"qty"`
+	IsBuyerMaker bool                `json:"isBuyerMaker"`
 }
 
 func (this *Client) PublicTradingRecords(v PublicTradingRecords) ([]PublicTradingRecord, error) {
@@ -136,16 +138,16 @@ func (this SymbolLatestInformation) Do(client *Client) ([]LatestInformation, err
 }
 
 type LatestInformation struct {
-	Time         uint64 `json:"time"`
-	Symbol       string `json:"symbol"`
-	BestBidPrice string `json:"bestBidPrice"`
-	BestAskPrice string `json:"bestAskPrice"`
-	Volume       string `json:"volume"`
-	QuoteVolume  string `json:"quoteVolume"`
-	LastPrice    string `json:"lastPrice"`
-	HighPrice    string `json:"highPrice"`
-	LowPrice     string `json:"lowPrice"`
-	OpenPrice    string `json:"openPrice"`
+	Time         transport.Timestamp `json:"time"`
+	Symbol       string              `json:"symbol"`
+	BestBidPrice transport.Decimal   `json:"bestBidPrice"`
+	BestAskPrice transport.Decimal   `json:"bestAskPrice"`
+	Volume       transport.Decimal   `json:"volume"`
+	QuoteVolume  transport.Decimal   `json:"quoteVolume"`
+	LastPrice    transport.Decimal   `json:"lastPrice"`
+	HighPrice    transport.Decimal   `json:"highPrice"`
+	LowPrice     transport.Decimal   `json:"lowPrice"`
+	OpenPrice    transport.Decimal   `json:"openPrice"`
 }
 
 func (this *Client) SymbolLatestInformation(symbol *string) ([]LatestInformation, error) {
@@ -162,8 +164,8 @@ func (this LastTradedPrice) Do(client *Client) (SymbolPrice, error) {
 }
 
 type SymbolPrice struct {
-	Symbol string `json:"symbol"`
-	Price  string `json:"price"`
+	Symbol string            `json:"symbol"`
+	Price  transport.Decimal `json:"price"`
 }
 
 func (this *Client) LastTradedPrice(symbol string) (SymbolPrice, error) {
@@ -180,12 +182,12 @@ func (this BestBidAskPrice) Do(client *Client) (BestBidAskPriceResult, error) {
 }
 
 type BestBidAskPriceResult struct {
-	Symbol   string `json:"symbol"`
-	BidPrice string `json:"bidPrice"`
-	BidQty   string `json:"bidQty"`
-	AskPrice string `json:"askPrice"`
-	AskQty   string `json:"askQty"`
-	Time     uint64 `json:"time"`
+	Symbol   string              `json:"symbol"`
+	BidPrice transport.Decimal   `json:"bidPrice"`
+	BidQty   transport.Decimal   `json:"bidQty"`
+	AskPrice transport.Decimal   `json:"askPrice"`
+	AskQty   transport.Decimal   `json:"askQty"`
+	Time     transport.Timestamp `json:"time"`
 }
 
 func (this *Client) BestBidAskPrice(symbol string) (BestBidAskPriceResult, error) {
@@ -1,10 +1,17 @@
 package spot
 
+import "github.com/ginarea/gobybit/transport"
+
 type Response[T any] struct {
-	RetCode int    `json:"ret_code"`
-	RetMsg  string `json:"ret_msg"`
-	ExtCode string `json:"ext_code"`
-	ExtInfo string `json:"ext_info"`
-	TimeNow string `json:"time_now"`
-	Result  T      `json:"result"`
+	RetCode int            `json:"ret_code"`
+	RetMsg  string         `json:"ret_msg"`
+	ExtCode string         `json:"ext_code"`
+	ExtInfo string         `json:"ext_info"`
+	TimeNow string         `json:"time_now"`
+	Result  T              `json:"result"`
+	Meta    transport.Meta `json:"-"`
+}
+
+func (this *Response[T]) SetMeta(meta transport.Meta) {
+	this.Meta = meta
 }
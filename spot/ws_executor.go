@@ -0,0 +1,45 @@
+package spot
+
+import "github.com/ginarea/gobybit/transport"
+
+type WsExecutor[T any] struct {
+	section wsSection
+	topic   TopicName
+	params  TopicParams
+}
+
+func NewWsExecutor[T any](section wsSection, topic TopicName, params TopicParams) *WsExecutor[T] {
+	return &WsExecutor[T]{
+		section: section,
+		topic:   topic,
+		params:  params,
+	}
+}
+
+func (this *WsExecutor[T]) Subscribe(onShot func(T)) {
+	this.section.subscribe(this.topic, this.params, func(m []byte) error {
+		return WsFunc(m, onShot)
+	})
+}
+
+func (this *WsExecutor[T]) Unsubscribe() {
+	this.section.unsubscribe(this.topic, this.params)
+}
+
+func (this *WsExecutor[T]) Instant() *WsInstant[T] {
+	return NewWsInstant[T](this)
+}
+
+// Values through a channel of size buffered values instead of a callback
+func (this *WsExecutor[T]) Chan(size int, overflow transport.WsOverflow) *transport.WsChan[T] {
+	c := transport.NewWsChan[T](size, overflow, this.Unsubscribe)
+	this.Subscribe(c.Push)
+	return c
+}
+
+type wsSection interface {
+	subscribe(topic TopicName, params TopicParams, f SubscriptionFunc)
+	unsubscribe(topic TopicName, params TopicParams)
+}
+
+type SubscriptionFunc func(m []byte) error
@@ -0,0 +1,30 @@
+package spot
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Topic data comes both as an object and as a list of objects; each object is passed to f
+func WsFunc[T any, F func(T)](m []byte, f F) error {
+	if bytes.HasPrefix(bytes.TrimSpace(m), []byte("[")) {
+		var l []T
+		if err := json.Unmarshal(m, &l); err != nil {
+			return err
+		}
+		if f != nil {
+			for _, v := range l {
+				f(v)
+			}
+		}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(m, &v); err != nil {
+		return err
+	}
+	if f != nil {
+		f(v)
+	}
+	return nil
+}
@@ -1,10 +1,11 @@
 package spot
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ginarea/gobybit/transport"
@@ -15,9 +16,11 @@ type WsPrivate struct {
 	log    *ulog.Log
 	ws     *transport.WsClient
 	key    string
-	secret string
+	signer transport.Signer
 	userID string
 	onAuth func(bool)
+	mutex  sync.Mutex
+	events map[TopicName]SubscriptionFunc
 }
 
 func NewWsPrivate(url string, key string, secret string) *WsPrivate {
@@ -26,10 +29,16 @@ func NewWsPrivate(url string, key string, secret string) *WsPrivate {
 		log:    ulog.Empty(),
 		ws:     ws,
 		key:    key,
-		secret: secret,
+		signer: transport.NewHmacSigner(secret),
+		events: make(map[TopicName]SubscriptionFunc),
 	}
 }
 
+func (this *WsPrivate) WithSigner(signer transport.Signer) *WsPrivate {
+	this.signer = signer
+	return this
+}
+
 func (this *WsPrivate) Shutdown() {
 	this.log.Debug("shutdown")
 	this.ws.Shutdown()
@@ -44,6 +53,15 @@ func (this *WsPrivate) WithLog(log *ulog.Log) *WsPrivate {
 	return this
 }
 
+func (this *WsPrivate) WithUrl(url string) *WsPrivate {
+	this.ws.WithUrl(url)
+	return this
+}
+
+func (this *WsPrivate) WithProfile(profile transport.Profile) *WsPrivate {
+	return this.WithUrl(profile.WsSpotPrivateV1)
+}
+
 func (this *WsPrivate) WithProxy(proxy string) *WsPrivate {
 	this.ws.WithProxy(proxy)
 	return this
@@ -65,22 +83,41 @@ func (this *WsPrivate) SetOnAuth(onAuth func(bool)) {
 	this.onAuth = onAuth
 }
 
+// Decode, protocol and unknown message errors with the raw frame
+func (this *WsPrivate) SetOnError(onError func(*transport.WsError)) {
+	this.ws.SetOnError(onError)
+}
+
+// Private events arrive once authorized, without subscription
+func (this *WsPrivate) OutboundAccountInfo() *WsExecutor[OutboundAccountInfo] {
+	return NewWsExecutor[OutboundAccountInfo](this, TopicOutboundAccountInfo, TopicParams{})
+}
+
+func (this *WsPrivate) ExecutionReport() *WsExecutor[ExecutionReport] {
+	return NewWsExecutor[ExecutionReport](this, TopicExecutionReport, TopicParams{})
+}
+
+func (this *WsPrivate) TicketInfo() *WsExecutor[TicketInfo] {
+	return NewWsExecutor[TicketInfo](this, TopicTicketInfo, TopicParams{})
+}
+
+func (this *WsPrivate) subscribe(topic TopicName, params TopicParams, f SubscriptionFunc) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.events[topic] = f
+}
+
+func (this *WsPrivate) unsubscribe(topic TopicName, params TopicParams) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	delete(this.events, topic)
+}
+
 func (this *WsPrivate) auth() {
-	expires := time.Now().Unix()*1000 + 10000
-	req := fmt.Sprintf("GET/realtime%d", expires)
-	sig := hmac.New(sha256.New, []byte(this.secret))
-	sig.Write([]byte(req))
-	signature := hex.EncodeToString(sig.Sum(nil))
-	cmd := struct {
-		Name string `json:"op"`
-		Args []any  `json:"args"`
-	}{
-		Name: "auth",
-		Args: []any{
-			this.key,
-			expires,
-			signature,
-		},
+	cmd, err := transport.WsAuth(this.key, this.signer, time.Now())
+	if err != nil {
+		this.log.Error("auth:", err)
+		return
 	}
 	this.ws.Send(cmd)
 }
@@ -88,28 +125,79 @@ func (this *WsPrivate) auth() {
 func (this *WsPrivate) processMessage(name string, msg []byte) {
 	switch name {
 	case "pong":
-		v := transport.JsonUnmarshal[struct {
+		v, err := transport.JsonUnmarshal[struct {
 			Pong string `json:"pong"`
 		}](msg)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
 		this.log.Debug("pong:", v.Pong)
 	case "auth":
-		v := transport.JsonUnmarshal[struct {
+		v, err := transport.JsonUnmarshal[struct {
 			Auth   string `json:"auth"`
 			UserID string `json:"userId"`
 		}](msg)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
 		this.log.Infof("auth[%s] user[%s]", v.Auth, v.UserID)
 		success := v.Auth == "success"
 		this.userID = v.UserID
+		this.ws.NotifyAuth(success)
 		if this.onAuth != nil {
 			this.onAuth(success)
 		}
 	case "code":
-		v := transport.JsonUnmarshal[struct {
+		v, err := transport.JsonUnmarshal[struct {
 			Code        string `json:"code"`
 			Description string `json:"desc"`
 		}](msg)
-		this.log.Warningf("code[%s]: %s", v.Code, v.Description)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
+		this.reportError(transport.ErrWsProtocol, name, msg, fmt.Errorf("code[%s]: %s", v.Code, v.Description))
+	case "e":
+		this.processEvents(msg)
 	default:
-		panic("unknown message type")
+		this.reportError(transport.ErrWsProtocol, name, msg, errors.New("unknown message"))
+	}
+}
+
+// Events come as a list of objects named by field "e"
+func (this *WsPrivate) processEvents(msg []byte) {
+	l := []json.RawMessage{msg}
+	if bytes.HasPrefix(msg, []byte("[")) {
+		var err error
+		if l, err = transport.JsonUnmarshal[[]json.RawMessage](msg); err != nil {
+			this.reportError(transport.ErrDecode, "e", msg, err)
+			return
+		}
+	}
+	for _, m := range l {
+		v, err := transport.JsonUnmarshal[struct {
+			Event TopicName       `json:"e"`
+			Time  json.RawMessage `json:"E"` // keeps "E" from matching "e"
+		}](m)
+		if err != nil {
+			this.reportError(transport.ErrDecode, "e", msg, err)
+			continue
+		}
+		this.mutex.Lock()
+		f := this.events[v.Event]
+		this.mutex.Unlock()
+		if f == nil {
+			this.log.Debug("event not subscribed:", v.Event)
+			continue
+		}
+		if err := f(m); err != nil {
+			this.reportError(transport.ErrDecode, string(v.Event), msg, err)
+		}
 	}
 }
+
+func (this *WsPrivate) reportError(kind error, name string, msg []byte, err error) {
+	this.ws.ReportError(transport.NewWsError(kind, name, msg, err))
+}
@@ -1,12 +1,18 @@
 package spot
 
 import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
 	"github.com/ginarea/gobybit/transport"
 	"github.com/msw-x/moon/ulog"
 )
 
 type WsPublic struct {
 	ws            *WsPublicTiny
+	mutex         sync.Mutex
 	subscriptions map[TopicName]topicSymbolsSet
 }
 
@@ -30,42 +36,144 @@ func (this *WsPublic) WithLog(log *ulog.Log) *WsPublic {
 	return this
 }
 
+func (this *WsPublic) WithUrl(url string) *WsPublic {
+	this.ws.WithUrl(url)
+	return this
+}
+
+func (this *WsPublic) WithProfile(profile transport.Profile) *WsPublic {
+	return this.WithUrl(profile.WsSpotPublicV1)
+}
+
 func (this *WsPublic) WithProxy(proxy string) *WsPublic {
 	this.ws.WithProxy(proxy)
 	return this
 }
 
+func (this *WsPublic) SetOnError(onError func(*transport.WsError)) {
+	this.ws.SetOnError(onError)
+}
+
+func (this *WsPublic) Connected() bool {
+	return this.ws.Connected()
+}
+
 func (this *WsPublic) Run() {
-	this.ws.SetOnConnected(func() {
-		for topic, symbols := range this.subscriptions {
-			for symbol := range symbols {
-				this.ws.Subscribe(topic, symbol)
-			}
-		}
-	})
+	this.ws.SetOnConnected(this.subscribeAll)
+	this.ws.SetOnTopic(this.processTopic)
+	this.ws.ws.SetOnResubscribe(this.resubscribe)
 	this.ws.Run()
 }
 
-func (this *WsPublic) Subscribe(topic TopicName, symbol string) {
+func (this *WsPublic) Depth(symbol string) *WsExecutor[TopicDataDepth] {
+	return NewWsExecutor[TopicDataDepth](this, TopicDepth, TopicParams{Symbol: symbol})
+}
+
+// Depth merged to dumpScale decimals of price; one subscription per symbol
+func (this *WsPublic) MergedDepth(symbol string, dumpScale int) *WsExecutor[TopicDataDepth] {
+	return NewWsExecutor[TopicDataDepth](this, TopicMergedDepth, TopicParams{
+		Symbol:    symbol,
+		DumpScale: json.Number(strconv.Itoa(dumpScale)),
+	})
+}
+
+// First message is the full depth, next ones are changes (zero quantity removes price level)
+func (this *WsPublic) DiffDepth(symbol string) *WsExecutor[TopicDataDepth] {
+	return NewWsExecutor[TopicDataDepth](this, TopicDiffDepth, TopicParams{Symbol: symbol})
+}
+
+func (this *WsPublic) Kline(symbol string, interval KlineInterval) *WsExecutor[TopicDataKline] {
+	return NewWsExecutor[TopicDataKline](this, TopicKline, TopicParams{Symbol: symbol, KlineType: interval})
+}
+
+func (this *WsPublic) Trade(symbol string) *WsExecutor[TopicDataTrade] {
+	return NewWsExecutor[TopicDataTrade](this, TopicTrade, TopicParams{Symbol: symbol})
+}
+
+func (this *WsPublic) BookTicker(symbol string) *WsExecutor[TopicDataBookTicker] {
+	return NewWsExecutor[TopicDataBookTicker](this, TopicBookTicker, TopicParams{Symbol: symbol})
+}
+
+func (this *WsPublic) Realtimes(symbol string) *WsExecutor[TopicDataRealtimes] {
+	return NewWsExecutor[TopicDataRealtimes](this, TopicRealtimes, TopicParams{Symbol: symbol})
+}
+
+func (this *WsPublic) subscribe(topic TopicName, params TopicParams, f SubscriptionFunc) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
 	sub, ok := this.subscriptions[topic]
 	if !ok {
 		sub = make(topicSymbolsSet)
+		this.subscriptions[topic] = sub
 	}
-	sub[symbol] = true
-	this.subscriptions[topic] = sub
+	sub[params.key()] = topicSubscription{params: params, f: f}
 	if this.ws.Connected() {
-		this.ws.Subscribe(topic, symbol)
+		this.ws.SubscribeParams(topic, params)
+		this.Conf().Watchdog.Watch(watchdogTopic(topic, params))
 	}
 }
 
-func (this *WsPublic) Unsubscribe(topic TopicName, symbol string) {
-	sub, ok := this.subscriptions[topic]
-	if ok {
-		delete(sub, symbol)
+func (this *WsPublic) unsubscribe(topic TopicName, params TopicParams) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if sub, ok := this.subscriptions[topic]; ok {
+		delete(sub, params.key())
+		if len(sub) == 0 {
+			delete(this.subscriptions, topic)
+		}
 	}
 	if this.ws.Connected() {
-		this.ws.Unsubscribe(topic, symbol)
+		this.ws.UnsubscribeParams(topic, params)
 	}
+	this.Conf().Watchdog.Forget(watchdogTopic(topic, params))
+}
+
+func (this *WsPublic) subscribeAll() {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	for topic, symbols := range this.subscriptions {
+		for _, s := range symbols {
+			this.ws.SubscribeParams(topic, s.params)
+			this.Conf().Watchdog.Watch(watchdogTopic(topic, s.params))
+		}
+	}
+}
+
+func (this *WsPublic) resubscribe(topic string) {
+	name, key, _ := strings.Cut(topic, ".")
+	this.mutex.Lock()
+	s, ok := this.subscriptions[TopicName(name)][key]
+	this.mutex.Unlock()
+	if ok {
+		this.ws.UnsubscribeParams(TopicName(name), s.params)
+		this.ws.SubscribeParams(TopicName(name), s.params)
+	}
+}
+
+func (this *WsPublic) processTopic(v TopicNotification[json.RawMessage], msg []byte) {
+	topic := watchdogTopic(v.Topic, v.Params)
+	this.mutex.Lock()
+	s, ok := this.subscriptions[v.Topic][v.Params.key()]
+	this.mutex.Unlock()
+	if !ok {
+		this.ws.reportError(transport.ErrUnknownTopic, topic, msg, nil)
+		return
+	}
+	this.Conf().Watchdog.Touch(topic)
+	if err := s.f(v.Data); err != nil {
+		this.ws.reportError(transport.ErrDecode, topic, msg, err)
+	}
+}
+
+// Topic of watchdog: name, symbol and kline interval joined by dots
+func watchdogTopic(topic TopicName, params TopicParams) string {
+	return string(topic) + "." + params.key()
+}
+
+type topicSubscription struct {
+	params TopicParams
+	f      SubscriptionFunc
 }
 
-type topicSymbolsSet map[string]bool
+// Subscriptions of topic by symbol (and kline interval)
+type topicSymbolsSet map[string]topicSubscription
@@ -1,6 +1,8 @@
 package spot
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/ginarea/gobybit/transport"
@@ -8,8 +10,9 @@ import (
 )
 
 type WsPublicTiny struct {
-	log *ulog.Log
-	ws  *transport.WsClient
+	log     *ulog.Log
+	ws      *transport.WsClient
+	onTopic func(TopicNotification[json.RawMessage], []byte)
 }
 
 func NewWsPublicTiny(url string) *WsPublicTiny {
@@ -34,6 +37,15 @@ func (this *WsPublicTiny) WithLog(log *ulog.Log) *WsPublicTiny {
 	return this
 }
 
+func (this *WsPublicTiny) WithUrl(url string) *WsPublicTiny {
+	this.ws.WithUrl(url)
+	return this
+}
+
+func (this *WsPublicTiny) WithProfile(profile transport.Profile) *WsPublicTiny {
+	return this.WithUrl(profile.WsSpotPublicV1)
+}
+
 func (this *WsPublicTiny) WithProxy(proxy string) *WsPublicTiny {
 	this.ws.WithProxy(proxy)
 	return this
@@ -53,75 +65,114 @@ func (this *WsPublicTiny) SetOnConnected(onConnected func()) {
 	this.ws.SetOnConnected(onConnected)
 }
 
+// Topic notifications (and raw frames) are passed to onTopic instead of being logged
+func (this *WsPublicTiny) SetOnTopic(onTopic func(TopicNotification[json.RawMessage], []byte)) {
+	this.onTopic = onTopic
+}
+
+// Decode, protocol and unknown topic errors with the raw frame
+func (this *WsPublicTiny) SetOnError(onError func(*transport.WsError)) {
+	this.ws.SetOnError(onError)
+}
+
 func (this *WsPublicTiny) Subscribe(topic TopicName, symbol string) bool {
-	this.log.Infof("subscribe: topic[%s] symbol[%s] ", symbol, topic)
+	return this.SubscribeParams(topic, TopicParams{Symbol: symbol})
+}
+
+func (this *WsPublicTiny) Unsubscribe(topic TopicName, symbol string) bool {
+	return this.UnsubscribeParams(topic, TopicParams{Symbol: symbol})
+}
+
+func (this *WsPublicTiny) SubscribeParams(topic TopicName, params TopicParams) bool {
+	this.log.Infof("subscribe: topic[%s] symbol[%s]", topic, params.key())
 	return this.ws.Send(Topic{
-		Name:  topic,
-		Event: TopicEventSub,
-		Params: TopicParams{
-			Symbol: symbol,
-		},
+		Name:   topic,
+		Event:  TopicEventSub,
+		Params: params,
 	})
 }
 
-func (this *WsPublicTiny) Unsubscribe(topic TopicName, symbol string) bool {
-	this.log.Infof("unsubscribe: topic[%s] symbol[%s]", symbol, topic)
+func (this *WsPublicTiny) UnsubscribeParams(topic TopicName, params TopicParams) bool {
+	this.log.Infof("unsubscribe: topic[%s] symbol[%s]", topic, params.key())
 	return this.ws.Send(Topic{
-		Name:  topic,
-		Event: TopicEventCancel,
-		Params: TopicParams{
-			Symbol: symbol,
-		},
+		Name:   topic,
+		Event:  TopicEventCancel,
+		Params: params,
 	})
 }
 
 func (this *WsPublicTiny) processMessage(name string, msg []byte) {
 	switch name {
 	case "pong":
-		v := transport.JsonUnmarshal[struct {
+		v, err := transport.JsonUnmarshal[struct {
 			Pong int `json:"pong"`
 		}](msg)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
 		this.log.Debug("pong:", v.Pong)
 	case "code":
-		v := transport.JsonUnmarshal[struct {
+		v, err := transport.JsonUnmarshal[struct {
 			Code        string `json:"code"`
 			Description string `json:"desc"`
 		}](msg)
-		this.log.Warningf("code[%s]: %s", v.Code, v.Description)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
+		this.reportError(transport.ErrWsProtocol, name, msg, fmt.Errorf("code[%s]: %s", v.Code, v.Description))
 	case "topic":
-		v := transport.JsonUnmarshal[TopicSubscribtion](msg)
-		if v.HasCode() {
-			//success := v.Ok()
+		v, err := transport.JsonUnmarshal[TopicSubscribtion](msg)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
+		if !v.HasCode() {
+			this.processTopic(msg)
+		} else if v.Ok() {
 			this.log.Infof("topic %s (%s) subscribtion: %s (%s)", v.Topic.Name, v.Params.Symbol, v.Message, v.Code)
 		} else {
-			this.processTopic(msg)
+			this.reportError(transport.ErrWsProtocol, string(v.Topic.Name), msg, fmt.Errorf("code[%s]: %s", v.Code, v.Message))
 		}
 	default:
-		panic("unknown message type")
+		this.reportError(transport.ErrWsProtocol, name, msg, errors.New("unknown message"))
 	}
 }
 
 func (this *WsPublicTiny) processTopic(msg []byte) {
-	v := transport.JsonUnmarshal[TopicNotification[any]](msg)
+	v, err := transport.JsonUnmarshal[TopicNotification[json.RawMessage]](msg)
+	if err != nil {
+		this.reportError(transport.ErrDecode, "topic", msg, err)
+		return
+	}
+	if this.onTopic != nil {
+		this.onTopic(v, msg)
+		return
+	}
 	var data any
 	switch v.Topic {
-	case TopicDepth:
-		v := transport.JsonUnmarshal[TopicNotification[TopicDataDepth]](msg)
-		data = v.Data
+	case TopicDepth, TopicMergedDepth, TopicDiffDepth:
+		data, err = transport.JsonUnmarshal[TopicDataDepth](v.Data)
 	case TopicKline:
-		v := transport.JsonUnmarshal[TopicNotification[TopicDataKline]](msg)
-		data = v.Data
+		data, err = transport.JsonUnmarshal[TopicDataKline](v.Data)
 	case TopicTrade:
-		v := transport.JsonUnmarshal[TopicNotification[TopicDataTrade]](msg)
-		data = v.Data
+		data, err = transport.JsonUnmarshal[TopicDataTrade](v.Data)
 	case TopicBookTicker:
-		v := transport.JsonUnmarshal[TopicNotification[TopicDataBookTicker]](msg)
-		data = v.Data
+		data, err = transport.JsonUnmarshal[TopicDataBookTicker](v.Data)
 	case TopicRealtimes:
-		v := transport.JsonUnmarshal[TopicNotification[TopicDataRealtimes]](msg)
-		data = v.Data
+		data, err = transport.JsonUnmarshal[TopicDataRealtimes](v.Data)
 	default:
-		panic(fmt.Sprintf("unknown topic name: %s", v.Topic))
+		this.reportError(transport.ErrUnknownTopic, string(v.Topic), msg, nil)
+		return
+	}
+	if err != nil {
+		this.reportError(transport.ErrDecode, string(v.Topic), msg, err)
+		return
 	}
 	this.log.Infof("topic %s (%s) notify: %+v", v.Topic, v.Params.Symbol, data)
 }
+
+func (this *WsPublicTiny) reportError(kind error, name string, msg []byte, err error) {
+	this.ws.ReportError(transport.NewWsError(kind, name, msg, err))
+}
@@ -1,13 +1,28 @@
 package spot
 
+import (
+	"encoding/json"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 type TopicName string
 
 const (
-	TopicDepth      TopicName = "depth"
-	TopicKline      TopicName = "kline"
-	TopicTrade      TopicName = "trade"
-	TopicBookTicker TopicName = "bookTicker"
-	TopicRealtimes  TopicName = "realtimes"
+	TopicDepth       TopicName = "depth"
+	TopicMergedDepth TopicName = "mergedDepth"
+	TopicDiffDepth   TopicName = "diffDepth"
+	TopicKline       TopicName = "kline"
+	TopicTrade       TopicName = "trade"
+	TopicBookTicker  TopicName = "bookTicker"
+	TopicRealtimes   TopicName = "realtimes"
+)
+
+// Private stream events
+const (
+	TopicOutboundAccountInfo TopicName = "outboundAccountInfo"
+	TopicExecutionReport     TopicName = "executionReport"
+	TopicTicketInfo          TopicName = "ticketInfo"
 )
 
 type TopicEvent string
@@ -28,6 +43,15 @@ type TopicParams struct {
 	Binary     string        `json:"binary"`
 	SymbolName string        `json:"symbolName"`
 	KlineType  KlineInterval `json:"klineType"`
+	DumpScale  json.Number   `json:"dumpScale,omitempty"` // Merged depth precision
+}
+
+// Subscription key within topic: symbol and kline interval
+func (this TopicParams) key() string {
+	if this.KlineType != "" {
+		return this.Symbol + "." + string(this.KlineType)
+	}
+	return this.Symbol
 }
 
 type TopicNotification[T any] struct {
@@ -51,49 +75,111 @@ func (this *TopicSubscribtion) HasCode() bool {
 }
 
 type TopicDataDepth struct {
-	Timestamp uint64     `json:"t"` // Timestamp (last update time of the order book)
-	Symbol    string     `json:"s"` // Trading pair
-	Version   string     `json:"v"` // Version
-	Bids      [][]string `json:"b"` // Best bid price, quantity
-	Asks      [][]string `json:"a"` // Best ask price, quantity
+	Timestamp transport.Timestamp   `json:"t"` // Timestamp (last update time of the order book)
+	Symbol    string                `json:"s"` // Trading pair
+	Version   string                `json:"v"` // Version
+	Bids      [][]transport.Decimal `json:"b"` // Best bid price, quantity
+	Asks      [][]transport.Decimal `json:"a"` // Best ask price, quantity
 }
 
 type TopicDataKline struct {
-	Timestamp     uint64 `json:"t"`  // Starting time
-	Symbol        string `json:"s"`  // Trading pair
-	SymbolName    string `json:"sn"` // Trading pair
-	ClosePrice    string `json:"c"`  // Close price
-	HighPrice     string `json:"h"`  // High price
-	LowPrice      string `json:"l"`  // Low price
-	OpenPrice     string `json:"o"`  // Open price
-	TradingVolume string `json:"v"`  // Trading volume
+	Timestamp     transport.Timestamp `json:"t"`  // Starting time
+	Symbol        string              `json:"s"`  // Trading pair
+	SymbolName    string              `json:"sn"` // Trading pair
+	ClosePrice    transport.Decimal   `json:"c"`  // Close price
+	HighPrice     transport.Decimal   `json:"h"`  // High price
+	LowPrice      transport.Decimal   `json:"l"`  // Low price
+	OpenPrice     transport.Decimal   `json:"o"`  // Open price
+	TradingVolume transport.Decimal   `json:"v"`  // Trading volume
 }
 
 type TopicDataTrade struct {
-	TradeID   string `json:"v"` // Trade ID
-	Timestamp uint64 `json:"t"` // Timestamp (trading time in the match box)
-	Price     string `json:"p"` // Price
-	Quantity  string `json:"q"` // Quantity
-	M         bool   `json:"m"` // True indicates buy side is taker, false indicates sell side is taker
+	TradeID   string              `json:"v"` // Trade ID
+	Timestamp transport.Timestamp `json:"t"` // Timestamp (trading time in the match box)
+	Price     transport.Decimal   `json:"p"` // Price
+	Quantity  transport.Decimal   `json:"q"` // Quantity
+	M         bool                `json:"m"` // True indicates buy side is taker, false indicates sell side is taker
 }
 
 type TopicDataBookTicker struct {
-	Symbol    string `json:"s"`        // Trading pair
-	BidPrice  string `json:"bidPrice"` // Best bid price
-	BidQty    string `json:"bidQty"`   // Bid quantity
-	AskPrice  string `json:"askPrice"` // Best ask price
-	AskQty    string `json:"askQty"`   // Ask quantity
-	Timestamp uint64 `json:"time"`     // Timestamp (last update time of the order book)
+	Symbol    string              `json:"s"`        // Trading pair
+	BidPrice  transport.Decimal   `json:"bidPrice"` // Best bid price
+	BidQty    transport.Decimal   `json:"bidQty"`   // Bid quantity
+	AskPrice  transport.Decimal   `json:"askPrice"` // Best ask price
+	AskQty    transport.Decimal   `json:"askQty"`   // Ask quantity
+	Timestamp transport.Timestamp `json:"time"`     // Timestamp (last update time of the order book)
 }
 
 type TopicDataRealtimes struct {
-	Timestamp          uint64 `json:"t"`  // Timestamp (trading time in the match box)
-	Symbol             string `json:"s"`  // Trading pair
-	ClosePrice         string `json:"c"`  // Close price
-	HighPrice          string `json:"h"`  // High price
-	LowPrice           string `json:"l"`  // Low price
-	OpenPrice          string `json:"o"`  // Open price
-	TradingVolume      string `json:"v"`  // Trading volume
-	TradingQuoteVolume string `json:"qv"` // Trading quote volume
-	Change             string `json:"m"`  // Change
+	Timestamp          transport.Timestamp `json:"t"`  // Timestamp (trading time in the match box)
+	Symbol             string              `json:"s"`  // Trading pair
+	ClosePrice         transport.Decimal   `json:"c"`  // Close price
+	HighPrice          transport.Decimal   `json:"h"`  // High price
+	LowPrice           transport.Decimal   `json:"l"`  // Low price
+	OpenPrice          transport.Decimal   `json:"o"`  // Open price
+	TradingVolume      transport.Decimal   `json:"v"`  // Trading volume
+	TradingQuoteVolume transport.Decimal   `json:"qv"` // Trading quote volume
+	Change             string              `json:"m"`  // Change
+}
+
+type OutboundAccountInfo struct {
+	Event         TopicName           `json:"e"` // Event type
+	Timestamp     transport.Timestamp `json:"E"` // Event time
+	AllowTrade    bool                `json:"T"` // Allow trade
+	AllowWithdraw bool                `json:"W"` // Allow withdraw
+	AllowDeposit  bool                `json:"D"` // Allow deposit
+	Balances      []OutboundBalance   `json:"B"` // Wallet balance change
+}
+
+type OutboundBalance struct {
+	Asset  string `json:"a"` // Coin name
+	Free   string `json:"f"` // Available balance
+	Locked string `json:"l"` // Reserved for orders
+}
+
+type ExecutionReport struct {
+	Event              TopicName           `json:"e"` // Event type
+	Timestamp          transport.Timestamp `json:"E"` // Event time
+	Symbol             string              `json:"s"` // Trading pair
+	OrderLinkID        string              `json:"c"` // User-generated order ID
+	Side               Side                `json:"S"` // BUY or SELL
+	Type               OrderType           `json:"o"` // Order type
+	TimeInForce        TimeInForce         `json:"f"` // Time in force
+	Qty                transport.Decimal   `json:"q"` // Quantity
+	Price              transport.Decimal   `json:"p"` // Price
+	Status             OrderStatus         `json:"X"` // Order status
+	OrderID            string              `json:"i"` // Order ID
+	OppositeOrderID    string              `json:"M"` // Order ID of the opponent trader
+	LastFilledQty      transport.Decimal   `json:"l"` // Last filled quantity
+	CumulativeQty      transport.Decimal   `json:"z"` // Total filled quantity
+	LastPrice          transport.Decimal   `json:"L"` // Last traded price
+	Fee                transport.Decimal   `json:"n"` // Trading fee (for a single fill)
+	FeeAsset           string              `json:"N"` // Asset type in which fee is paid
+	NormalOrder        bool                `json:"u"` // Is normal trade
+	Working            bool                `json:"w"` // Is working
+	Maker              bool                `json:"m"` // Is LIMIT_MAKER
+	CreateTime         transport.Timestamp `json:"O"` // Order creation time
+	CumulativeQuoteQty transport.Decimal   `json:"Z"` // Total filled value
+	AccountID          string              `json:"A"` // Account ID
+	Close              bool                `json:"C"` // Is close
+	Leverage           string              `json:"v"` // Leverage
+	Liquidity          string              `json:"d"` // NO_LIQ indicates that it is not a liquidation order
+	TradeID            string              `json:"t"` // Trade ID
+}
+
+type TicketInfo struct {
+	Event          TopicName           `json:"e"` // Event type
+	Timestamp      transport.Timestamp `json:"E"` // Event time
+	Symbol         string              `json:"s"` // Trading pair
+	Qty            transport.Decimal   `json:"q"` // Quantity
+	TradeTime      transport.Timestamp `json:"t"` // Time
+	Price          transport.Decimal   `json:"p"` // Price
+	TradeID        string              `json:"T"` // Trade ID
+	OrderID        string              `json:"o"` // Order ID
+	OrderLinkID    string              `json:"c"` // User-generated order ID
+	MatchOrderID   string              `json:"O"` // Order ID of the opponent trader
+	AccountID      string              `json:"a"` // Account ID
+	MatchAccountID string              `json:"A"` // Account ID of the opponent trader
+	Maker          bool                `json:"m"` // Is maker
+	Side           Side                `json:"S"` // BUY or SELL
 }
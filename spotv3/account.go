@@ -1,18 +1,24 @@
 // Account Data Endpoints (https://bybit-exchange.github.io/docs/spot/v3/#t-accountdata)
 package spotv3
 
+import (
+	"context"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 type OrderBase struct {
-	AccountID   string      `json:"accountId"`
-	OrderID     string      `json:"orderId"`
-	OrderLinkID string      `json:"orderLinkId"`
-	Symbol      string      `json:"symbol"`
-	CreatedTime uint64      `json:"createTime"`
-	Price       string      `json:"orderPrice"`
-	OrigQty     string      `json:"orderQty"`
-	OrderType   OrderType   `json:"orderType"`
-	Side        Side        `json:"side"`
-	OrderStatus OrderStatus `json:"status"`
-	TimeInForce TimeInForce `json:"timeInForce"`
+	AccountID   string              `json:"accountId"`
+	OrderID     string              `json:"orderId"`
+	OrderLinkID string              `json:"orderLinkId"`
+	Symbol      string              `json:"symbol"`
+	CreatedTime transport.Timestamp `json:"createTime"`
+	Price       transport.Decimal   `json:"orderPrice"`
+	OrigQty     transport.Decimal   `json:"orderQty"`
+	OrderType   OrderType           `json:"orderType"`
+	Side        Side                `json:"side"`
+	OrderStatus OrderStatus         `json:"status"`
+	TimeInForce TimeInForce         `json:"timeInForce"`
 }
 
 // Place Active Order (https://bybit-exchange.github.io/docs/spot/v1/#t-placeactive)
@@ -28,25 +34,28 @@ type OrderBase struct {
 //	                       the price field is required
 //	orderLinkId          string User-generated order ID
 type PlaceOrder struct {
-	Symbol        string       `json:"symbol"`
-	Qty           int          `json:"orderQty"`
-	Side          Side         `json:"side"`
-	Type          OrderType    `json:"orderType"`
-	TimeInForce   *TimeInForce `json:"timeInForce"`
-	Price         *Price       `json:"orderPrice"`
-	OrderLinkID   *string      `json:"orderLinkId"`
-	OrderCategory *int         `json:"orderCategory"`
-	TriggerPrice  *string      `json:"triggerPrice"`
+	Symbol        string             `json:"symbol"`
+	Qty           transport.Decimal  `json:"orderQty"`
+	Side          Side               `json:"side"`
+	Type          OrderType          `json:"orderType"`
+	TimeInForce   *TimeInForce       `json:"timeInForce"`
+	Price         *transport.Decimal `json:"orderPrice"`
+	OrderLinkID   *string            `json:"orderLinkId"`
+	OrderCategory *int               `json:"orderCategory"`
+	TriggerPrice  *transport.Decimal `json:"triggerPrice"`
 }
 
 func (this PlaceOrder) Do(client *Client) (OrderCreated, error) {
+	if err := this.prepare(client); err != nil {
+		return OrderCreated{}, err
+	}
 	return Post[OrderCreated](client, "order", this)
 }
 
 type OrderCreated struct {
 	OrderBase
-	OrderCategory int    `json:"orderCategory"`
-	TriggerPrice  string `json:"triggerPrice"`
+	OrderCategory int               `json:"orderCategory"`
+	TriggerPrice  transport.Decimal `json:"triggerPrice"`
 }
 
 func (this *Client) PlaceOrder(v PlaceOrder) (OrderCreated, error) {
@@ -90,7 +99,7 @@ func (this CancelOrder) Do(client *Client) (OrderCancelled, error) {
 
 type OrderCancelled struct {
 	OrderBase
-	ExecQty string `json:"execQty"`
+	ExecQty transport.Decimal `json:"execQty"`
 }
 
 func (this *Client) CancelOrder(v CancelOrder) (OrderCancelled, error) {
@@ -190,15 +199,32 @@ func (this OrderHistory) Do(client *Client) ([]OpenedOrder, error) {
 	return r.List, err
 }
 
+// Iterator over all orders from the newest, each page starts below the last order id
+func (this OrderHistory) Iter(client *Client) *transport.Pager[OpenedOrder] {
+	key := func(v OpenedOrder) string {
+		return v.OrderID
+	}
+	return transport.NewPager(client.Context(), transport.ByID(transport.Deref(this.OrderID), transport.Deref(this.Limit), key, func(ctx context.Context, id string) ([]OpenedOrder, error) {
+		if id != "" {
+			this.OrderID = &id
+		}
+		type result struct {
+			List []OpenedOrder `json:"list"`
+		}
+		r, err := GetContext[result](ctx, client, "history-orders", this)
+		return r.List, err
+	}))
+}
+
 type OpenedOrder struct {
 	OrderBase
-	ExecQty             string `json:"execQty"`
-	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
-	AvgPrice            string `json:"avgPrice"`
-	StopPrice           string `json:"stopPrice"`
-	IcebergQty          string `json:"icebergQty"`
-	UpdateTime          uint64 `json:"updateTime"`
-	IsWorking           string `json:"isWorking"`
+	ExecQty             transport.Decimal   `json:"execQty"`
+	CummulativeQuoteQty transport.Decimal   `json:"cummulativeQuoteQty"`
+	AvgPrice            transport.Decimal   `json:"avgPrice"`
+	StopPrice           transport.Decimal   `json:"stopPrice"`
+	IcebergQty          transport.Decimal   `json:"icebergQty"`
+	UpdateTime          transport.Timestamp `json:"updateTime"`
+	IsWorking           string              `json:"isWorking"`
 }
 
 func (this *Client) OrderHistory(v OrderHistory) ([]OpenedOrder, error) {
@@ -233,20 +259,20 @@ func (this TradeHistory) Do(client *Client) ([]Trade, error) {
 }
 
 type Trade struct {
-	ID            string `json:"id"`
-	Symbol        string `json:"symbol"`
-	OrderID       string `json:"orderId"`
-	TradeID       string `json:"tradeId"`
-	Price         string `json:"orderPrice"`
-	Qty           string `json:"orderQty"`
-	ExecFee       string `json:"execFee"`
-	FeeTokenId    string `json:"feeTokenId"`
-	CreatedTime   string `json:"createdTime"`
-	IsBuyer       string `json:"isBuyer"`
-	IsMaker       string `json:"isMaker"`
-	MatchOrderID  string `json:"matchOrderId"`
-	MakerRebate   string `json:"makerRebate"`
-	ExecutionTime string `json:"executionTime"`
+	ID            string              `json:"id"`
+	Symbol        string              `json:"symbol"`
+	OrderID       string              `json:"orderId"`
+	TradeID       string              `json:"tradeId"`
+	Price         transport.Decimal   `json:"orderPrice"`
+	Qty           transport.Decimal   `json:"orderQty"`
+	ExecFee       transport.Decimal   `json:"execFee"`
+	FeeTokenId    string              `json:"feeTokenId"`
+	CreatedTime   transport.Timestamp `json:"createdTime"`
+	IsBuyer       string              `json:"isBuyer"`
+	IsMaker       string              `json:"isMaker"`
+	MatchOrderID  string              `json:"matchOrderId"`
+	MakerRebate   string              `json:"makerRebate"`
+	ExecutionTime transport.Timestamp `json:"executionTime"`
 }
 
 func (this *Client) TradeHistory(v TradeHistory) ([]Trade, error) {
@@ -1,11 +1,17 @@
 // API Data Endpoints (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-api)
 package spotv3
 
+import (
+	"time"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 // Server Time (https://bybit-exchange.github.io/docs/spot/v3/#t-servertime)
-func (this *Client) ServerTime() (string, error) {
+func (this *Client) ServerTime() (time.Time, error) {
 	type result struct {
-		ServerTime string `json:"serverTime"`
+		ServerTime transport.Timestamp `json:"serverTime"`
 	}
 	r, err := GetPublic[result](this, "server-time", nil)
-	return r.ServerTime, err
+	return r.ServerTime.Time(), err
 }
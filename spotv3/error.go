@@ -10,10 +10,69 @@ type Error struct {
 	transport.Err
 }
 
+// Error codes (https://bybit-exchange.github.io/docs/spot/v3/#t-errors)
+var errorCodes = transport.CodeMap{
+	10009: transport.ErrAuth,                // IP has been banned
+	10027: transport.ErrAuth,                // Trading is banned for the account
+	10029: transport.ErrInvalidParam,        // Symbol is not in the API key whitelist
+	12001: transport.ErrInvalidParam,        // Invalid parameter
+	12002: transport.ErrInvalidParam,        // Invalid symbol
+	12003: transport.ErrInvalidParam,        // Invalid side
+	12004: transport.ErrInvalidParam,        // Invalid order type
+	12005: transport.ErrInvalidParam,        // Invalid time in force
+	12112: transport.ErrInvalidParam,        // No depth for the symbol
+	12121: transport.ErrInvalidParam,        // Invalid symbol
+	12130: transport.ErrInvalidParam,        // Invalid parameter sent
+	12131: transport.ErrInsufficientBalance, // Insufficient balance
+	12132: transport.ErrInvalidParam,        // Order price exceeds the maximum allowed
+	12133: transport.ErrInvalidParam,        // Order price is below the minimum allowed
+	12134: transport.ErrInvalidParam,        // Order price decimal too long
+	12135: transport.ErrInvalidParam,        // Order qty exceeds the maximum allowed
+	12136: transport.ErrInvalidParam,        // Order qty is below the minimum allowed
+	12137: transport.ErrInvalidParam,        // Order qty decimal too long
+	12138: transport.ErrInvalidParam,        // Order price exceeds the permissible range
+	12139: transport.ErrOrderNotFound,       // Order has been filled
+	12140: transport.ErrInvalidParam,        // Order value is below the minimum allowed
+	12141: transport.ErrInvalidParam,        // Duplicate clientOrderId
+	12142: transport.ErrOrderNotFound,       // Order has been cancelled
+	12143: transport.ErrOrderNotFound,       // Order not found on order book
+	12144: transport.ErrInvalidParam,        // Order has been locked
+	12145: transport.ErrInvalidParam,        // Order type does not support cancellation
+	12146: transport.ErrServer,              // Order creation timeout
+	12147: transport.ErrServer,              // Order cancellation timeout
+	12148: transport.ErrInvalidParam,        // Market order amount decimal too long
+	12149: transport.ErrServer,              // Create order failed
+	12150: transport.ErrServer,              // Cancel order failed
+	12151: transport.ErrInvalidParam,        // Trading pair is not open yet
+	12156: transport.ErrInvalidParam,        // Trading pair does not support API trading
+	12157: transport.ErrInvalidParam,        // Market order qty exceeds the maximum allowed
+	12158: transport.ErrInvalidParam,        // Market order value exceeds the maximum allowed
+	12159: transport.ErrInvalidParam,        // Number of open orders exceeds the limit
+	12201: transport.ErrInvalidParam,        // Invalid orderCategory parameter
+	12202: transport.ErrInsufficientBalance, // Insufficient loan balance
+	12203: transport.ErrInvalidParam,        // Unsupported order type
+	12204: transport.ErrInvalidParam,        // Invalid trigger price
+	12205: transport.ErrInvalidParam,        // Number of stop orders exceeds the limit
+	12206: transport.ErrInvalidParam,        // Stop order qty exceeds the maximum allowed
+	12207: transport.ErrInvalidParam,        // Stop order value is below the minimum allowed
+	12208: transport.ErrInvalidParam,        // Trigger price exceeds the permissible range
+	12209: transport.ErrServer,              // Create stop order failed
+	12210: transport.ErrServer,              // Cancel stop order failed
+	12211: transport.ErrOrderNotFound,       // Stop order has been triggered
+	12212: transport.ErrOrderNotFound,       // Stop order has been cancelled
+	12213: transport.ErrOrderNotFound,       // Order does not exist
+	-1000: transport.ErrServer,              // Unknown error
+	-1001: transport.ErrServer,              // Internal error
+	-1021: transport.ErrAuth,                // Timestamp outside of recv_window
+	-1022: transport.ErrAuth,                // Invalid signature
+	-1121: transport.ErrInvalidParam,        // Invalid symbol
+	-2013: transport.ErrOrderNotFound,       // Order does not exist
+}
+
 func forwardError(err error) error {
 	var terr *transport.Error
 	if errors.As(err, &terr) {
-		return &Error{Err: terr.Err}
+		return &Error{Err: terr.Err.Classify(errorCodes)}
 	}
 	return err
 }
@@ -0,0 +1,51 @@
+package spotv3
+
+import "github.com/ginarea/gobybit/transport"
+
+// Trading rules for order validation
+func (this SymbolInfo) Instrument() transport.Instrument {
+	return transport.Instrument{
+		Symbol:      this.Name,
+		TickSize:    decimal(this.MinPricePrecision),
+		QtyStep:     decimal(this.BasePrecision),
+		MinQty:      decimal(this.MinTradeQuantity),
+		MaxQty:      decimal(this.MaxTradeQuantity),
+		QuoteStep:   decimal(this.QuotePrecision),
+		MinNotional: decimal(this.MinTradeAmount),
+		MaxNotional: decimal(this.MaxTradeAmount),
+	}
+}
+
+// Registry of spot v3 symbols loaded by QuerySymbol
+func NewInstruments(client *Client) *transport.Instruments {
+	return transport.NewInstruments(func() ([]transport.Instrument, error) {
+		l, err := client.QuerySymbol()
+		if err != nil {
+			return nil, err
+		}
+		r := make([]transport.Instrument, len(l))
+		for i, v := range l {
+			r[i] = v.Instrument()
+		}
+		return r, nil
+	})
+}
+
+// Market buy qty is an amount of quote currency
+func (this *PlaceOrder) prepare(client *Client) error {
+	if client.instruments == nil {
+		return nil
+	}
+	// The order is a copy, but prices are shared with the caller
+	this.Price = transport.Clone(this.Price)
+	this.TriggerPrice = transport.Clone(this.TriggerPrice)
+	if this.Type == Market && this.Side == Buy {
+		return client.instruments.PrepareQuote(this.Symbol, &this.Qty)
+	}
+	return client.instruments.PrepareOrder(this.Symbol, &this.Qty, this.Price, this.TriggerPrice)
+}
+
+func decimal(s string) transport.Decimal {
+	d, _ := transport.ParseDecimal(s)
+	return d
+}
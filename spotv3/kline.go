@@ -0,0 +1,57 @@
+package spotv3
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
+// Max bars per kline request
+const KlineLimit = 1000
+
+// Fixed duration of the interval; zero for a month
+func (this KlineInterval) Duration() time.Duration {
+	s := string(this)
+	if s == "" {
+		return 0
+	}
+	n, _ := strconv.Atoi(s[:len(s)-1])
+	switch s[len(s)-1] {
+	case 'm':
+		return time.Duration(n) * time.Minute
+	case 'h':
+		return time.Duration(n) * time.Hour
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour
+	}
+	return 0
+}
+
+func (this KlineData) Bar() transport.Bar {
+	return transport.Bar{
+		Time:   this.Timestamp.Time(),
+		Open:   this.OpenPrice,
+		High:   this.HighPrice,
+		Low:    this.LowPrice,
+		Close:  this.ClosePrice,
+		Volume: this.TradingVolume,
+	}
+}
+
+// Downloader of bars of any range (StartTime, EndTime and Limit are set per page)
+func (this QueryKline) Download(client *Client) *transport.KlineDownloader {
+	fetch := func(ctx context.Context, start time.Time, end time.Time, limit int) ([]transport.Bar, error) {
+		from := int(start.UnixMilli())
+		to := int(end.UnixMilli()) - 1
+		this.StartTime = &from
+		this.EndTime = &to
+		this.Limit = &limit
+		l, err := this.Do(client.WithContext(ctx))
+		return transport.Bars(l), err
+	}
+	return transport.NewKlineDownloader(client.Context(), this.Interval.Duration(), KlineLimit, fetch)
+}
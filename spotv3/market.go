@@ -1,6 +1,8 @@
 // Market Data Endpoints (https://bybit-exchange.github.io/docs/spot/v3/#t-marketdata)
 package spotv3
 
+import "github.com/ginarea/gobybit/transport"
+
 // Query Symbol (https://bybit-exchange.github.io/docs/spot/v3/#t-spot_querysymbol)
 type SymbolInfo struct {
 	Name              string `json:"name"`
@@ -50,9 +52,9 @@ func (this OrderBook) Do(client *Client) (OrderBookResult, error) {
 }
 
 type OrderBookResult struct {
-	Time uint64 `json:"time"`
-	Bids [][]string
-	Asks [][]string
+	Time transport.Timestamp `json:"time"`
+	Bids [][]transport.Decimal
+	Asks [][]transport.Decimal
 }
 
 func (this *Client) OrderBook(v OrderBook) (OrderBookResult, error) {
@@ -96,10 +98,10 @@ func (this PublicTradingRecords) Do(client *Client) ([]PublicTradingRecord, erro
 }
 
 type PublicTradingRecord struct {
-	Price        string `json:"price"`
-	Time         uint64 `json:"time"`
-	Qty          string `json:"qty"`
-	IsBuyerMaker int    `json:"isBuyerMaker"`
+	Price        transport.Decimal   `json:"price"`
+	Time         transport.Timestamp `json:"time"`
+	Qty          transport.Decimal   `json:"qty"`
+	IsBuyerMaker int                 `json:"isBuyerMaker"`
 }
 
 func (this *Client) PublicTradingRecords(v PublicTradingRecords) ([]PublicTradingRecord, error) {
@@ -130,14 +132,14 @@ func (this QueryKline) Do(client *Client) ([]KlineData, error) {
 }
 
 type KlineData struct {
-	Timestamp     uint64 `json:"t"`
-	Symbol        string `json:"s"`
-	Alias         string `json:"sn"`
-	ClosePrice    string `json:"c"`
-	HighPrice     string `json:"h"`
-	LowPrice      string `json:"l"`
-	OpenPrice     string `json:"o"`
-	TradingVolume string `json:"v"`
+	Timestamp     transport.Timestamp `json:"t"`
+	Symbol        string              `json:"s"`
+	Alias         string              `json:"sn"`
+	ClosePrice    transport.Decimal   `json:"c"`
+	HighPrice     transport.Decimal   `json:"h"`
+	LowPrice      transport.Decimal   `json:"l"`
+	OpenPrice     transport.Decimal   `json:"o"`
+	TradingVolume transport.Decimal   `json:"v"`
 }
 
 func (this *Client) QueryKline(v QueryKline) ([]KlineData, error) {
@@ -163,16 +165,16 @@ func (this SymbolLatestInformation) Do(client *Client) ([]LatestInformation, err
 }
 
 type LatestInformation struct {
-	Time               uint64 `json:"t"`
-	Symbol             string `json:"s"`
-	LastTradedPrice    string `json:"lp"`
-	HighPrice          string `json:"h"`
-	LowPrice           string `json:"l"`
-	OpenPrice          string `json:"o"`
-	BestBidPrice       string `json:"bp"`
-	BestAskPrice       string `json:"ap"`
-	TradingVolume      string `json:"v"`
-	TradingQuoteVolume string `json:"qv"`
+	Time               transport.Timestamp `json:"t"`
+	Symbol             string              `json:"s"`
+	LastTradedPrice    transport.Decimal   `json:"lp"`
+	HighPrice          transport.Decimal   `json:"h"`
+	LowPrice           transport.Decimal   `json:"l"`
+	OpenPrice          transport.Decimal   `json:"o"`
+	BestBidPrice       transport.Decimal   `json:"bp"`
+	BestAskPrice       transport.Decimal   `json:"ap"`
+	TradingVolume      transport.Decimal   `json:"v"`
+	TradingQuoteVolume transport.Decimal   `json:"qv"`
 }
 
 func (this *Client) SymbolLatestInformation(symbol *string) ([]LatestInformation, error) {
@@ -189,8 +191,8 @@ func (this LastTradedPrice) Do(client *Client) (SymbolPrice, error) {
 }
 
 type SymbolPrice struct {
-	Symbol string `json:"symbol"`
-	Price  string `json:"price"`
+	Symbol string            `json:"symbol"`
+	Price  transport.Decimal `json:"price"`
 }
 
 func (this *Client) LastTradedPrice(symbol string) (SymbolPrice, error) {
@@ -207,12 +209,12 @@ func (this BestBidAskPrice) Do(client *Client) (BestBidAskPriceResult, error) {
 }
 
 type BestBidAskPriceResult struct {
-	Symbol   string `json:"symbol"`
-	BidPrice string `json:"bidPrice"`
-	BidQty   string `json:"bidQty"`
-	AskPrice string `json:"askPrice"`
-	AskQty   string `json:"askQty"`
-	Time     uint64 `json:"time"`
+	Symbol   string              `json:"symbol"`
+	BidPrice transport.Decimal   `json:"bidPrice"`
+	BidQty   transport.Decimal   `json:"bidQty"`
+	AskPrice transport.Decimal   `json:"askPrice"`
+	AskQty   transport.Decimal   `json:"askQty"`
+	Time     transport.Timestamp `json:"time"`
 }
 
 func (this *Client) BestBidAskPrice(symbol string) (BestBidAskPriceResult, error) {
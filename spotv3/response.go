@@ -1,8 +1,15 @@
 package spotv3
 
+import "github.com/ginarea/gobybit/transport"
+
 type Response[T any] struct {
-	RetCode int    `json:"retCode"`
-	RetMsg  string `json:"retMsg"`
-	Time    uint64 `json:"time"`
-	Result  T      `json:"result"`
+	RetCode int                 `json:"retCode"`
+	RetMsg  string              `json:"retMsg"`
+	Time    transport.Timestamp `json:"time"`
+	Result  T                   `json:"result"`
+	Meta    transport.Meta      `json:"-"`
+}
+
+func (this *Response[T]) SetMeta(meta transport.Meta) {
+	this.Meta = meta
 }
@@ -0,0 +1,40 @@
+package spotv3
+
+import "github.com/ginarea/gobybit/transport"
+
+type WsExecutor[T any] struct {
+	section      wsSection
+	subscription Subscription
+}
+
+func NewWsExecutor[T any](section wsSection, subscription Subscription) *WsExecutor[T] {
+	e := &WsExecutor[T]{}
+	e.Init(section, subscription)
+	return e
+}
+
+func (this *WsExecutor[T]) Init(section wsSection, subscription Subscription) {
+	this.section = section
+	this.subscription = subscription
+}
+
+func (this *WsExecutor[T]) Subscribe(onShot func(T)) {
+	this.section.subscribe(this.subscription, func(m []byte) error {
+		return WsFunc(m, onShot)
+	})
+}
+
+func (this *WsExecutor[T]) Unsubscribe() {
+	this.section.unsubscribe(this.subscription)
+}
+
+func (this *WsExecutor[T]) Instant() *WsInstant[T] {
+	return NewWsInstant[T](this)
+}
+
+// Values through a channel of size buffered values instead of a callback
+func (this *WsExecutor[T]) Chan(size int, overflow transport.WsOverflow) *transport.WsChan[T] {
+	c := transport.NewWsChan[T](size, overflow, this.Unsubscribe)
+	this.Subscribe(c.Push)
+	return c
+}
@@ -6,13 +6,13 @@ import (
 )
 
 type WsPublic struct {
-	ws *WsClient
+	WsSection
 }
 
 func NewWsPublic() *WsPublic {
-	return &WsPublic{
-		ws: NewWsClient("public", "wss://stream.bybit.com/spot/public/v3"),
-	}
+	c := &WsPublic{}
+	c.init(NewWsClient("public", transport.DefaultProfile().WsSpotPublic))
+	return c
 }
 
 func (this *WsPublic) Shutdown() {
@@ -28,6 +28,15 @@ func (this *WsPublic) WithLog(log *ulog.Log) *WsPublic {
 	return this
 }
 
+func (this *WsPublic) WithUrl(url string) *WsPublic {
+	this.ws.WithUrl(url)
+	return this
+}
+
+func (this *WsPublic) WithProfile(profile transport.Profile) *WsPublic {
+	return this.WithUrl(profile.WsSpotPublic)
+}
+
 func (this *WsPublic) WithProxy(proxy string) *WsPublic {
 	this.Conf().SetProxy(proxy)
 	return this
@@ -41,41 +50,39 @@ func (this *WsPublic) Run() {
 	this.ws.Run()
 }
 
+func (this *WsPublic) Ready() bool {
+	return this.ws.Ready()
+}
+
 func (this *WsPublic) SetOnConnected(onConnected func()) {
 	this.ws.SetOnConnected(onConnected)
 }
 
-func (this *WsPublic) SubscribeDepth(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicDepth, Interval: "40", Symbol: &symbol})
-}
-func (this *WsPublic) UnsubscribeDepth(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicDepth, Interval: "40", Symbol: &symbol})
+func (this *WsPublic) SetOnDisconnected(onDisconnected func()) {
+	this.ws.SetOnDisconnected(onDisconnected)
 }
 
-func (this *WsPublic) SubscribeTrade(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicTrade, Symbol: &symbol})
-}
-func (this *WsPublic) UnsubscribeTrade(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicTrade, Symbol: &symbol})
+func (this *WsPublic) SetOnError(onError func(*transport.WsError)) {
+	this.ws.SetOnError(onError)
 }
 
-func (this *WsPublic) SubscribeKline(symbol string, interval KlineInterval) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicKline, Interval: string(interval), Symbol: &symbol})
-}
-func (this *WsPublic) UnsubscribeKline(symbol string, interval KlineInterval) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicKline, Interval: string(interval), Symbol: &symbol})
+// Order book of 40 levels
+func (this *WsPublic) Depth(symbol string) *WsExecutor[DepthDelta] {
+	return NewWsExecutor[DepthDelta](&this.WsSection, Subscription{Topic: TopicDepth, Interval: "40", Symbol: &symbol})
 }
 
-func (this *WsPublic) SubscribeTickers(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicTickers, Symbol: &symbol})
+func (this *WsPublic) Trade(symbol string) *WsExecutor[TradeDelta] {
+	return NewWsExecutor[TradeDelta](&this.WsSection, Subscription{Topic: TopicTrade, Symbol: &symbol})
 }
-func (this *WsPublic) UnsubscribeTickers(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicTickers, Symbol: &symbol})
+
+func (this *WsPublic) Kline(symbol string, interval KlineInterval) *WsExecutor[KlineDelta] {
+	return NewWsExecutor[KlineDelta](&this.WsSection, Subscription{Topic: TopicKline, Interval: string(interval), Symbol: &symbol})
 }
 
-func (this *WsPublic) SubscribeBookTicker(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicBookTicker, Symbol: &symbol})
+func (this *WsPublic) Tickers(symbol string) *WsExecutor[TickersDelta] {
+	return NewWsExecutor[TickersDelta](&this.WsSection, Subscription{Topic: TopicTickers, Symbol: &symbol})
 }
-func (this *WsPublic) UnsubscribeBookTicker(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicBookTicker, Symbol: &symbol})
+
+func (this *WsPublic) BookTicker(symbol string) *WsExecutor[BookTickerDelta] {
+	return NewWsExecutor[BookTickerDelta](&this.WsSection, Subscription{Topic: TopicBookTicker, Symbol: &symbol})
 }
@@ -2,12 +2,10 @@ package transport
 
 import (
 	"bytes"
-	"crypto/hmac"
-	"crypto/sha256"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -21,20 +19,32 @@ import (
 )
 
 type Client struct {
-	log         *ulog.Log
-	url         string
-	key         string
-	secret      string
-	proxy       *url.URL
-	logUri      bool
-	logResponse bool
+	log          *ulog.Log
+	url          string
+	key          string
+	secret       string
+	signer       Signer
+	proxy        *url.URL
+	logUri       bool
+	logResponse  bool
+	http         *http.Client
+	ownTransport bool
+	retry        *RetryPolicy
+	limiter      *Limiter
+	clock        *Clock
+	recvWindow   time.Duration
+	recorder     *Recorder
+	interceptors []Interceptor
 }
 
 func NewClient() *Client {
-	return &Client{
+	o := &Client{
 		log: ulog.Empty(),
-		url: MainBaseUrl,
+		url: DefaultProfile().Rest,
 	}
+	o.http = &http.Client{Transport: o.newTransport()}
+	o.ownTransport = true
+	return o
 }
 
 func (o *Client) WithUrl(url string) *Client {
@@ -47,9 +57,22 @@ func (o *Client) WithByTickUrl() *Client {
 	return o
 }
 
+func (o *Client) WithProfile(profile Profile) *Client {
+	o.url = profile.Rest
+	return o
+}
+
 func (o *Client) WithAuth(key, secret string) *Client {
 	o.key = key
 	o.secret = secret
+	o.signer = NewHmacSigner(secret)
+	return o
+}
+
+func (o *Client) WithAuthSigner(key string, signer Signer) *Client {
+	o.key = key
+	o.secret = ""
+	o.signer = signer
 	return o
 }
 
@@ -59,6 +82,64 @@ func (o *Client) WithProxy(proxy string) *Client {
 	if err != nil {
 		panic(fmt.Sprintf("set proxy fail: %v", err))
 	}
+	if o.ownTransport {
+		o.http.Transport = o.newTransport()
+	}
+	return o
+}
+
+// Use own http client (timeouts, dialers, TLS config, test doubles).
+// The client is used as is: proxy set by WithProxy is not applied to it
+func (o *Client) WithHTTPClient(client *http.Client) *Client {
+	o.http = client
+	o.ownTransport = false
+	return o
+}
+
+// Use own round tripper with the pooled http client
+func (o *Client) WithRoundTripper(rt http.RoundTripper) *Client {
+	c := *o.http
+	c.Transport = rt
+	o.http = &c
+	o.ownTransport = false
+	return o
+}
+
+// Retry failed requests (network errors, 5xx, temporary exchange errors) by policy.
+// Nil disables retries (default)
+func (o *Client) WithRetry(policy *RetryPolicy) *Client {
+	o.retry = policy
+	return o
+}
+
+// Throttle requests by client-side rate limiter. Nil disables it (default)
+func (o *Client) WithLimiter(limiter *Limiter) *Client {
+	o.limiter = limiter
+	return o
+}
+
+// Write every request and response to the recorder (credentials are stripped)
+func (o *Client) WithRecorder(recorder *Recorder) *Client {
+	o.recorder = recorder
+	return o
+}
+
+// Append interceptors to the chain around each request attempt.
+// They run after the default ones (logging, recording)
+func (o *Client) WithInterceptor(interceptors ...Interceptor) *Client {
+	o.interceptors = append(o.interceptors, interceptors...)
+	return o
+}
+
+// Sign requests with server-corrected time
+func (o *Client) WithClock(clock *Clock) *Client {
+	o.clock = clock
+	return o
+}
+
+// Validity window of signed requests (recv_window); zero means exchange default
+func (o *Client) WithRecvWindow(recvWindow time.Duration) *Client {
+	o.recvWindow = recvWindow
 	return o
 }
 
@@ -85,7 +166,60 @@ func (o *Client) Secret() string {
 	return o.secret
 }
 
-func (o *Client) Request(method string, path string, param any, ret any, sign bool) (err error) {
+func (o *Client) Signer() Signer {
+	return o.signer
+}
+
+func (o *Client) HTTPClient() *http.Client {
+	return o.http
+}
+
+func (o *Client) Clock() *Clock {
+	return o.clock
+}
+
+func (o *Client) now() time.Time {
+	if o.clock != nil {
+		return o.clock.Now()
+	}
+	return time.Now()
+}
+
+func (o *Client) Request(method string, path string, param any, ret any, sign bool) error {
+	return o.RequestContext(context.Background(), method, path, param, ret, sign)
+}
+
+func (o *Client) RequestContext(ctx context.Context, method string, path string, param any, ret any, sign bool) (err error) {
+	p := NewParam().From(param)
+	retry := o.retry != nil && o.retry.Allowed(method, p)
+	for attempt := 1; ; attempt++ {
+		var temporary bool
+		temporary, err = o.request(ctx, method, path, p, ret, sign, attempt)
+		if err == nil || !retry || !temporary || attempt >= o.retry.MaxAttempts {
+			return
+		}
+		delay := o.retry.Delay(attempt)
+		o.log.Warningf("%s[%s]: retry %d/%d in %s", method, path, attempt, o.retry.MaxAttempts-1, delay)
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			err = ctx.Err()
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (o *Client) chain() []Interceptor {
+	l := []Interceptor{LogInterceptor(o.log, o.logUri, o.logResponse)}
+	if o.recorder != nil {
+		l = append(l, o.recorder.Interceptor())
+	}
+	return append(l, o.interceptors...)
+}
+
+func (o *Client) request(ctx context.Context, method string, path string, p Param, ret any, sign bool, attempt int) (temporary bool, err error) {
 	logf := func(format string, a ...any) {
 		m := fmt.Sprintf(format, a...)
 		if err == nil {
@@ -94,6 +228,17 @@ func (o *Client) Request(method string, path string, param any, ret any, sign bo
 			o.log.Errorf("%s[%s]: %s", method, path, m)
 		}
 	}
+	if err = ctx.Err(); err != nil {
+		logf("%v", err)
+		return
+	}
+	group := limitGroup(method, path, sign)
+	if o.limiter != nil {
+		if err = o.limiter.Wait(ctx, group, path); err != nil {
+			logf("limiter: %v", err)
+			return
+		}
+	}
 	timestamp := time.Now()
 	u, err := url.Parse(o.url)
 	if err != nil {
@@ -101,14 +246,11 @@ func (o *Client) Request(method string, path string, param any, ret any, sign bo
 		return
 	}
 	u.Path = path
-	p := NewParam().From(param)
 	vals := p.Make()
-	var signHeader func(http.Header)
-	if sign {
-		if p.HeaderSign {
-			signHeader = o.signQueryHeader(vals)
-		} else {
-			vals = o.signQuery(vals)
+	if sign && !p.HeaderSign {
+		if vals, err = o.signQuery(vals); err != nil {
+			logf("sign fail: %v", err)
+			return
 		}
 	}
 	var reqbody []byte
@@ -124,10 +266,18 @@ func (o *Client) Request(method string, path string, param any, ret any, sign bo
 		u.RawQuery = vals.Encode()
 		u.RawQuery = strings.Replace(u.RawQuery, "%2C", ",", -1)
 	}
-	if o.logUri {
-		o.log.Debug("uri:", u.String())
+	var signHeader func(http.Header)
+	if sign && p.HeaderSign {
+		payload := u.RawQuery
+		if p.IsJson {
+			payload = string(reqbody)
+		}
+		if signHeader, err = o.signHeader(payload); err != nil {
+			logf("sign fail: %v", err)
+			return
+		}
 	}
-	req, err := http.NewRequest(method, u.String(), bytes.NewReader(reqbody))
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(reqbody))
 	if err != nil {
 		logf("init request fail: %v", err)
 		return
@@ -139,31 +289,51 @@ func (o *Client) Request(method string, path string, param any, ret any, sign bo
 	if signHeader != nil {
 		signHeader(req.Header)
 	}
-	client := &http.Client{}
-	if o.proxy != nil {
-		client.Transport = &http.Transport{
-			Proxy: http.ProxyURL(o.proxy),
-		}
+	x := &Exchange{
+		Method:      method,
+		Path:        path,
+		Signed:      sign,
+		Attempt:     attempt,
+		Time:        timestamp,
+		Request:     req,
+		RequestBody: reqbody,
+	}
+	err = Chain(roundTrip(o.http), o.chain()...)(x)
+	if err == nil && x.Response == nil {
+		err = &TransportError{Err: errors.New("interceptor returned no response")}
+	}
+	resp := x.Response
+	body := x.ResponseBody
+	elapsedTime := x.Latency.Truncate(time.Millisecond)
+	if resp != nil && o.limiter != nil {
+		o.limiter.Update(group, path, resp.Header)
 	}
-	resp, err := client.Do(req)
-	elapsedTime := time.Since(timestamp).Truncate(time.Millisecond)
 	if err != nil {
-		logf("request fail [%s]: %v", elapsedTime.String(), err)
+		temporary = ctx.Err() == nil
+		if resp == nil {
+			logf("request fail [%s]: %v", elapsedTime.String(), err)
+		} else {
+			logf("read body fail [%s]: %v", elapsedTime.String(), err)
+		}
 		return
 	}
-	defer resp.Body.Close()
-	body, _ := io.ReadAll(resp.Body)
+	meta := newMeta(resp, elapsedTime, body)
+	defer func() {
+		if r, ok := ret.(MetaReceiver); ok {
+			r.SetMeta(meta)
+		}
+		if m := metaFromContext(ctx); m != nil {
+			*m = meta
+		}
+	}()
 	m := fmt.Sprintf("%s %s", resp.Status, elapsedTime.String())
 	if len(body) >= 0 {
 		m = fmt.Sprintf("%s %s", m, ufmt.ByteSizeDense(len(body)))
-		if o.logResponse {
-			o.log.Debug("response:", string(body))
-		}
 	}
 	ok := resp.StatusCode == http.StatusOK
 	if ok {
 		if len(body) == 0 {
-			err = errors.New("response body is empty")
+			err = &DecodeError{Err: errors.New("response body is empty")}
 			logf("%v", err)
 			return
 		}
@@ -176,18 +346,23 @@ func (o *Client) Request(method string, path string, param any, ret any, sign bo
 			}
 			s := reflect.ValueOf(ret)
 			s = s.Elem()
+			meta.fromEnvelope(s)
 			var e Error
 			e.Code = int(s.FieldByName("RetCode").Int())
 			e.Text = s.FieldByName("RetMsg").String()
 			if !e.Empty() {
+				e.Err = e.Classify(nil)
 				err = &e
+				temporary = o.retry != nil && o.retry.TemporaryCode(e.Code)
 				m = fmt.Sprintf("%s %v", m, err)
 			}
 		} else {
 			m = fmt.Sprintf("%s json unmarshal fail: %v", m, err)
+			err = &DecodeError{Err: err}
 		}
 	} else {
-		err = errors.New(fmt.Sprintf("http status-code: %d", resp.StatusCode))
+		err = &TransportError{Status: resp.StatusCode}
+		temporary = resp.StatusCode >= http.StatusInternalServerError
 		logf("%v", err)
 		return
 	}
@@ -196,53 +371,113 @@ func (o *Client) Request(method string, path string, param any, ret any, sign bo
 }
 
 func (o *Client) RequestPublic(method string, path string, param any, ret any) error {
-	return o.Request(method, path, param, ret, false)
+	return o.RequestPublicContext(context.Background(), method, path, param, ret)
 }
 
 func (o *Client) RequestPrivate(method string, path string, param any, ret any) error {
-	return o.Request(method, path, param, ret, true)
+	return o.RequestPrivateContext(context.Background(), method, path, param, ret)
+}
+
+func (o *Client) RequestPublicContext(ctx context.Context, method string, path string, param any, ret any) error {
+	return o.RequestContext(ctx, method, path, param, ret, false)
+}
+
+func (o *Client) RequestPrivateContext(ctx context.Context, method string, path string, param any, ret any) error {
+	return o.RequestContext(ctx, method, path, param, ret, true)
 }
 
 func (o *Client) GetPublic(path string, param any, ret any) error {
-	return o.RequestPublic(http.MethodGet, path, param, ret)
+	return o.GetPublicContext(context.Background(), path, param, ret)
 }
 
 func (o *Client) Get(path string, param any, ret any) error {
-	return o.RequestPrivate(http.MethodGet, path, param, ret)
+	return o.GetContext(context.Background(), path, param, ret)
 }
 
 func (o *Client) Post(path string, param any, ret any) error {
-	return o.RequestPrivate(http.MethodPost, path, param, ret)
+	return o.PostContext(context.Background(), path, param, ret)
 }
 
 func (o *Client) Delete(path string, param any, ret any) error {
-	return o.RequestPrivate(http.MethodDelete, path, param, ret)
+	return o.DeleteContext(context.Background(), path, param, ret)
+}
+
+func (o *Client) GetPublicContext(ctx context.Context, path string, param any, ret any) error {
+	return o.RequestPublicContext(ctx, http.MethodGet, path, param, ret)
+}
+
+func (o *Client) GetContext(ctx context.Context, path string, param any, ret any) error {
+	return o.RequestPrivateContext(ctx, http.MethodGet, path, param, ret)
+}
+
+func (o *Client) PostContext(ctx context.Context, path string, param any, ret any) error {
+	return o.RequestPrivateContext(ctx, http.MethodPost, path, param, ret)
+}
+
+func (o *Client) DeleteContext(ctx context.Context, path string, param any, ret any) error {
+	return o.RequestPrivateContext(ctx, http.MethodDelete, path, param, ret)
+}
+
+func (o *Client) newTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConnsPerHost = 16
+	if o.proxy != nil {
+		t.Proxy = http.ProxyURL(o.proxy)
+	}
+	return t
 }
 
-func (o *Client) signQuery(src url.Values) url.Values {
-	i := int(time.Now().UTC().UnixNano() / int64(time.Millisecond))
-	ts := strconv.Itoa(i)
+func (o *Client) signQuery(src url.Values) (url.Values, error) {
+	ts := strconv.FormatInt(o.now().UnixMilli(), 10)
 	if src == nil {
 		src = url.Values{}
 	}
 	src.Add("api_key", o.key)
 	src.Add("timestamp", ts)
-	src.Add("sign", makeSignature(src, o.secret))
-	return src
+	if o.recvWindow > 0 {
+		src.Add("recv_window", o.recvWindowString())
+	}
+	sign, err := o.makeSignature(src)
+	src.Add("sign", sign)
+	return src, err
 }
 
-func (o *Client) signQueryHeader(src url.Values) func(http.Header) {
-	i := int(time.Now().UTC().UnixNano() / int64(time.Millisecond))
-	ts := strconv.Itoa(i)
-	sign := makeSignature(src, o.secret)
+// V3 header signing: timestamp + api_key + recv_window + payload,
+// where payload is the query string (GET) or the json body (POST) as sent
+func (o *Client) signHeader(payload string) (func(http.Header), error) {
+	ts := strconv.FormatInt(o.now().UnixMilli(), 10)
+	recvWindow := ""
+	if o.recvWindow > 0 {
+		recvWindow = o.recvWindowString()
+	}
+	sign, err := o.sign([]byte(ts + o.key + recvWindow + payload))
 	return func(h http.Header) {
 		h.Set("X-BAPI-API-KEY", o.key)
 		h.Set("X-BAPI-TIMESTAMP", ts)
 		h.Set("X-BAPI-SIGN", sign)
+		if recvWindow != "" {
+			h.Set("X-BAPI-RECV-WINDOW", recvWindow)
+		}
+	}, err
+}
+
+func (o *Client) recvWindowString() string {
+	return strconv.FormatInt(o.recvWindow.Milliseconds(), 10)
+}
+
+func (o *Client) makeSignature(src url.Values) (string, error) {
+	return o.sign([]byte(signaturePayload(src)))
+}
+
+func (o *Client) sign(payload []byte) (string, error) {
+	signer := o.signer
+	if signer == nil {
+		signer = NewHmacSigner(o.secret)
 	}
+	return signer.Sign(payload)
 }
 
-func makeSignature(src url.Values, key string) string {
+func signaturePayload(src url.Values) string {
 	keys := make([]string, len(src))
 	i := 0
 	for k := range src {
@@ -254,11 +489,5 @@ func makeSignature(src url.Values, key string) string {
 	for _, k := range keys {
 		s += k + "=" + src.Get(k) + "&"
 	}
-	s = s[0 : len(s)-1]
-	h := hmac.New(sha256.New, []byte(key))
-	_, err := io.WriteString(h, s)
-	if err != nil {
-		panic(err)
-	}
-	return fmt.Sprintf("%x", h.Sum(nil))
+	return strings.TrimSuffix(s, "&")
 }
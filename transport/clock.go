@@ -0,0 +1,86 @@
+package transport
+
+import (
+	"sync"
+	"time"
+
+	"github.com/msw-x/moon/app"
+	"github.com/msw-x/moon/ulog"
+	"github.com/msw-x/moon/usync"
+)
+
+// Server time provider (for example a ServerTime endpoint)
+type ClockSource func() (time.Time, error)
+
+// Tracks local clock skew against the exchange server time
+type Clock struct {
+	log     *ulog.Log
+	do      *usync.Do
+	mutex   sync.RWMutex
+	offset  time.Duration
+	running bool
+}
+
+func NewClock() *Clock {
+	return &Clock{
+		log: ulog.Empty(),
+		do:  usync.NewDo(),
+	}
+}
+
+func (o *Clock) WithLog(log *ulog.Log) *Clock {
+	o.log = log
+	return o
+}
+
+// Local time corrected by server offset
+func (o *Clock) Now() time.Time {
+	return time.Now().Add(o.Offset())
+}
+
+func (o *Clock) Offset() time.Duration {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	return o.offset
+}
+
+func (o *Clock) SetOffset(offset time.Duration) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.offset = offset
+}
+
+// Measure offset once; server time is compared with the middle of the round trip
+func (o *Clock) Sync(source ClockSource) error {
+	t := time.Now()
+	serverTime, err := source()
+	if err != nil {
+		return err
+	}
+	rtt := time.Since(t)
+	offset := serverTime.Sub(t.Add(rtt / 2)).Truncate(time.Millisecond)
+	o.log.Debugf("offset: %s rtt: %s", offset, rtt.Truncate(time.Millisecond))
+	o.SetOffset(offset)
+	return nil
+}
+
+// Correct offset in background with the given interval
+func (o *Clock) Run(interval time.Duration, source ClockSource) {
+	o.running = true
+	app.Go(func() {
+		defer o.do.Notify()
+		for o.do.Do() {
+			if err := o.Sync(source); err != nil {
+				o.log.Error("sync:", err)
+			}
+			o.do.Sleep(interval)
+		}
+	})
+}
+
+func (o *Clock) Shutdown() {
+	o.do.Cancel()
+	if o.running {
+		o.do.Stop()
+	}
+}
@@ -0,0 +1,338 @@
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Exact decimal number (coefficient * 10^-scale) for prices and quantities.
+// Parsed from JSON strings or numbers, written to params and JSON without float artifacts.
+// Zero value is 0
+type Decimal struct {
+	coef  *big.Int
+	scale int32
+}
+
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // Half away from zero
+	RoundHalfEven                     // Half to even (banker's)
+	RoundDown                         // Toward zero
+	RoundUp                           // Away from zero
+	RoundFloor                        // Toward negative infinity
+	RoundCeil                         // Toward positive infinity
+)
+
+var bigTen = big.NewInt(10)
+
+func NewDecimal(coef int64, scale int32) Decimal {
+	return Decimal{coef: big.NewInt(coef), scale: scale}
+}
+
+func DecimalFromInt(v int64) Decimal {
+	return NewDecimal(v, 0)
+}
+
+// Shortest decimal that parses back to the float
+func DecimalFromFloat(v float64) Decimal {
+	d, err := ParseDecimal(strconv.FormatFloat(v, 'f', -1, 64))
+	if err != nil {
+		panic(fmt.Sprintf("decimal from float %v: %v", v, err))
+	}
+	return d
+}
+
+// Parses "123", "-1.5", "0.00012", "1e-8"
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, fmt.Errorf("parse decimal: empty string")
+	}
+	var exp int64
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("parse decimal %q: %v", s, err)
+		}
+		exp = e
+		s = s[:i]
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	coef, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("parse decimal %q: invalid syntax", s)
+	}
+	scale := int64(len(frac)) - exp
+	if scale < 0 {
+		coef.Mul(coef, pow10(int32(-scale)))
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: int32(scale)}, nil
+}
+
+func MustDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (o Decimal) int() *big.Int {
+	if o.coef == nil {
+		return new(big.Int)
+	}
+	return o.coef
+}
+
+// Coefficients of both numbers at the same scale
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	x, y := a.int(), b.int()
+	switch {
+	case a.scale < b.scale:
+		x = new(big.Int).Mul(x, pow10(b.scale-a.scale))
+		return x, y, b.scale
+	case a.scale > b.scale:
+		y = new(big.Int).Mul(y, pow10(a.scale-b.scale))
+	}
+	return x, y, a.scale
+}
+
+func (o Decimal) Add(v Decimal) Decimal {
+	x, y, scale := align(o, v)
+	return Decimal{coef: new(big.Int).Add(x, y), scale: scale}
+}
+
+func (o Decimal) Sub(v Decimal) Decimal {
+	x, y, scale := align(o, v)
+	return Decimal{coef: new(big.Int).Sub(x, y), scale: scale}
+}
+
+func (o Decimal) Mul(v Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(o.int(), v.int()), scale: o.scale + v.scale}
+}
+
+// Quotient rounded half up to the number of decimal places; panics on division by zero
+func (o Decimal) Div(v Decimal, places int32) Decimal {
+	return o.DivRound(v, places, RoundHalfUp)
+}
+
+func (o Decimal) DivRound(v Decimal, places int32, mode RoundingMode) Decimal {
+	if v.IsZero() {
+		panic("decimal division by zero")
+	}
+	// o/v = (a*10^-sa)/(b*10^-sb); scale numerator to get places+1 extra digit for rounding
+	a := new(big.Int).Set(o.int())
+	b := v.int()
+	shift := places + v.scale - o.scale
+	if shift >= 0 {
+		a.Mul(a, pow10(shift))
+	} else {
+		b = new(big.Int).Mul(b, pow10(-shift))
+	}
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	return Decimal{coef: roundQuo(q, r, b, mode), scale: places}
+}
+
+func (o Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(o.int()), scale: o.scale}
+}
+
+func (o Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(o.int()), scale: o.scale}
+}
+
+// Rounded to the number of decimal places (negative rounds to tens, hundreds...)
+func (o Decimal) RoundMode(places int32, mode RoundingMode) Decimal {
+	if o.scale <= places {
+		return o
+	}
+	d := pow10(o.scale - places)
+	q, r := new(big.Int).QuoRem(o.int(), d, new(big.Int))
+	return Decimal{coef: roundQuo(q, r, d, mode), scale: places}
+}
+
+func (o Decimal) Round(places int32) Decimal {
+	return o.RoundMode(places, RoundHalfUp)
+}
+
+func (o Decimal) Floor(places int32) Decimal {
+	return o.RoundMode(places, RoundFloor)
+}
+
+func (o Decimal) Ceil(places int32) Decimal {
+	return o.RoundMode(places, RoundCeil)
+}
+
+func (o Decimal) Truncate(places int32) Decimal {
+	return o.RoundMode(places, RoundDown)
+}
+
+// Rounded to a multiple of the step (tick size, qty step); zero step leaves the value as is
+func (o Decimal) RoundStep(step Decimal, mode RoundingMode) Decimal {
+	if step.IsZero() {
+		return o
+	}
+	return o.DivRound(step, 0, mode).Mul(step)
+}
+
+// Adjusts truncated quotient q of n/d (remainder r) by the rounding mode
+func roundQuo(q, r, d *big.Int, mode RoundingMode) *big.Int {
+	if r.Sign() == 0 {
+		return q
+	}
+	neg := r.Sign()*d.Sign() < 0
+	away := false
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		away = true
+	case RoundFloor:
+		away = neg
+	case RoundCeil:
+		away = !neg
+	default:
+		c := new(big.Int).Abs(r)
+		c.Lsh(c, 1)
+		switch cmp := c.Cmp(new(big.Int).Abs(d)); {
+		case cmp > 0:
+			away = true
+		case cmp == 0:
+			away = mode == RoundHalfUp || q.Bit(0) == 1
+		}
+	}
+	if !away {
+		return q
+	}
+	if neg {
+		return q.Sub(q, big.NewInt(1))
+	}
+	return q.Add(q, big.NewInt(1))
+}
+
+func (o Decimal) Cmp(v Decimal) int {
+	x, y, _ := align(o, v)
+	return x.Cmp(y)
+}
+
+func (o Decimal) Equal(v Decimal) bool {
+	return o.Cmp(v) == 0
+}
+
+func (o Decimal) LessThan(v Decimal) bool {
+	return o.Cmp(v) < 0
+}
+
+func (o Decimal) GreaterThan(v Decimal) bool {
+	return o.Cmp(v) > 0
+}
+
+func (o Decimal) Sign() int {
+	return o.int().Sign()
+}
+
+func (o Decimal) IsZero() bool {
+	return o.Sign() == 0
+}
+
+func (o Decimal) IsNotZero() bool {
+	return !o.IsZero()
+}
+
+// Number of digits after the point, trailing zeros excluded
+func (o Decimal) Places() int32 {
+	return o.normalize().scale
+}
+
+// Without trailing fractional zeros
+func (o Decimal) normalize() Decimal {
+	if o.scale <= 0 || o.IsZero() {
+		if o.IsZero() {
+			return Decimal{}
+		}
+		return o
+	}
+	coef := new(big.Int).Set(o.coef)
+	scale := o.scale
+	r := new(big.Int)
+	for scale > 0 {
+		q, m := new(big.Int).QuoRem(coef, bigTen, r)
+		if m.Sign() != 0 {
+			break
+		}
+		coef = q
+		scale--
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+func (o Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(o.String(), 64)
+	return f
+}
+
+// Plain notation without exponent and trailing zeros: "0.0001", "-12.5", "300"
+func (o Decimal) String() string {
+	n := o.normalize()
+	if n.scale <= 0 {
+		s := n.int().String()
+		if n.scale < 0 && s != "0" {
+			s += strings.Repeat("0", int(-n.scale))
+		}
+		return s
+	}
+	s := new(big.Int).Abs(n.coef).String()
+	if len(s) <= int(n.scale) {
+		s = strings.Repeat("0", int(n.scale)-len(s)+1) + s
+	}
+	p := len(s) - int(n.scale)
+	s = s[:p] + "." + s[p:]
+	if n.coef.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Fixed number of decimal places (rounded half up or padded with zeros)
+func (o Decimal) StringFixed(places int32) string {
+	d := o.Round(places)
+	s := d.String()
+	if places <= 0 {
+		return s
+	}
+	have := int32(0)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		have = int32(len(s) - i - 1)
+	} else {
+		s += "."
+	}
+	return s + strings.Repeat("0", int(places-have))
+}
+
+// Accepts JSON strings and numbers; empty string and null give zero
+func (o *Decimal) UnmarshalJSON(b []byte) error {
+	b = bytes.Trim(b, `"`)
+	if len(b) == 0 || string(b) == "null" {
+		*o = Decimal{}
+		return nil
+	}
+	d, err := ParseDecimal(string(b))
+	if err != nil {
+		return err
+	}
+	*o = d
+	return nil
+}
+
+// Encoded as JSON string
+func (o Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + o.String() + `"`), nil
+}
@@ -0,0 +1,247 @@
+package transport
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"123", "123"},
+		{"0", "0"},
+		{"-0.000", "0"},
+		{"-1.5", "-1.5"},
+		{"-2.50", "-2.5"},
+		{".5", "0.5"},
+		{"-.5", "-0.5"},
+		{" 7.10 ", "7.1"},
+		{"0.00012", "0.00012"},
+		{"100", "100"},
+		{"1e-8", "0.00000001"},
+		{"-1e-3", "-0.001"},
+		{"1.23e-2", "0.0123"},
+		{"1.5E3", "1500"},
+		{"12e2", "1200"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDecimal(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := d.String(); s != tt.want {
+				t.Fatalf("string %q, want %q", s, tt.want)
+			}
+			back, err := ParseDecimal(d.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !back.Equal(d) {
+				t.Errorf("round trip %s, want %s", back, d)
+			}
+		})
+	}
+}
+
+func TestParseDecimalError(t *testing.T) {
+	for _, in := range []string{"", " ", "abc", "1.2.3", "1e", "1ex", "--1"} {
+		if d, err := ParseDecimal(in); err == nil {
+			t.Errorf("%q: parsed as %s", in, d)
+		}
+	}
+}
+
+func TestDecimalStringFixed(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int32
+		want   string
+	}{
+		{"1.5", 3, "1.500"},
+		{"7", 2, "7.00"},
+		{"2.345", 2, "2.35"},
+		{"-2.345", 2, "-2.35"},
+		{"0.0001", 2, "0.00"},
+		{"15", 0, "15"},
+	}
+	for _, tt := range tests {
+		if s := MustDecimal(tt.in).StringFixed(tt.places); s != tt.want {
+			t.Errorf("%s fixed %d: %q, want %q", tt.in, tt.places, s, tt.want)
+		}
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int32
+		mode   RoundingMode
+		want   string
+	}{
+		{"2.5", 0, RoundHalfUp, "3"},
+		{"-2.5", 0, RoundHalfUp, "-3"},
+		{"2.4", 0, RoundHalfUp, "2"},
+		{"-2.6", 0, RoundHalfUp, "-3"},
+		{"1.25", 1, RoundHalfUp, "1.3"},
+		{"2.5", 0, RoundHalfEven, "2"},
+		{"3.5", 0, RoundHalfEven, "4"},
+		{"-2.5", 0, RoundHalfEven, "-2"},
+		{"-3.5", 0, RoundHalfEven, "-4"},
+		{"2.51", 0, RoundHalfEven, "3"},
+		{"1.25", 1, RoundHalfEven, "1.2"},
+		{"2.9", 0, RoundDown, "2"},
+		{"-2.9", 0, RoundDown, "-2"},
+		{"2.1", 0, RoundUp, "3"},
+		{"-2.1", 0, RoundUp, "-3"},
+		{"2.9", 0, RoundFloor, "2"},
+		{"-2.1", 0, RoundFloor, "-3"},
+		{"2.1", 0, RoundCeil, "3"},
+		{"-2.9", 0, RoundCeil, "-2"},
+		{"125", -1, RoundHalfUp, "130"},
+		{"1249", -2, RoundDown, "1200"},
+		{"1.5", 3, RoundUp, "1.5"},
+		{"2", 0, RoundUp, "2"},
+	}
+	for _, tt := range tests {
+		if d := MustDecimal(tt.in).RoundMode(tt.places, tt.mode); d.String() != tt.want {
+			t.Errorf("%s round %d mode %d: %s, want %s", tt.in, tt.places, tt.mode, d, tt.want)
+		}
+	}
+	d := MustDecimal("-1.2345")
+	for _, c := range []struct {
+		name string
+		v    Decimal
+		want string
+	}{
+		{"Round", d.Round(2), "-1.23"},
+		{"Floor", d.Floor(2), "-1.24"},
+		{"Ceil", d.Ceil(2), "-1.23"},
+		{"Truncate", d.Truncate(3), "-1.234"},
+	} {
+		if c.v.String() != c.want {
+			t.Errorf("%s: %s, want %s", c.name, c.v, c.want)
+		}
+	}
+}
+
+func TestDecimalRoundStep(t *testing.T) {
+	tests := []struct {
+		in   string
+		step string
+		mode RoundingMode
+		want string
+	}{
+		{"0.123", "0.05", RoundDown, "0.1"},
+		{"0.123", "0.05", RoundCeil, "0.15"},
+		{"0.125", "0.05", RoundHalfUp, "0.15"},
+		{"-0.123", "0.05", RoundFloor, "-0.15"},
+		{"19000.37", "0.5", RoundHalfEven, "19000.5"},
+		{"0.123", "0", RoundDown, "0.123"},
+	}
+	for _, tt := range tests {
+		if d := MustDecimal(tt.in).RoundStep(MustDecimal(tt.step), tt.mode); d.String() != tt.want {
+			t.Errorf("%s step %s mode %d: %s, want %s", tt.in, tt.step, tt.mode, d, tt.want)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustDecimal("1.05")
+	b := MustDecimal("-0.2")
+	tests := []struct {
+		name string
+		v    Decimal
+		want string
+	}{
+		{"Add", a.Add(b), "0.85"},
+		{"Sub", a.Sub(b), "1.25"},
+		{"Mul", a.Mul(b), "-0.21"},
+		{"Neg", b.Neg(), "0.2"},
+		{"Abs", b.Abs(), "0.2"},
+		{"Zero", Decimal{}.Add(a), "1.05"},
+	}
+	for _, tt := range tests {
+		if tt.v.String() != tt.want {
+			t.Errorf("%s: %s, want %s", tt.name, tt.v, tt.want)
+		}
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || !a.Equal(MustDecimal("1.050")) {
+		t.Error("compare")
+	}
+	if p := MustDecimal("1.2500").Places(); p != 2 {
+		t.Errorf("places %d, want 2", p)
+	}
+	if f := MustDecimal("-1.5").Float64(); f != -1.5 {
+		t.Errorf("float %v, want -1.5", f)
+	}
+}
+
+func TestDecimalDiv(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		places int32
+		mode   RoundingMode
+		want   string
+	}{
+		{"1", "3", 4, RoundHalfUp, "0.3333"},
+		{"2", "3", 4, RoundHalfUp, "0.6667"},
+		{"-2", "3", 4, RoundHalfUp, "-0.6667"},
+		{"1.5", "0.5", 2, RoundHalfUp, "3"},
+		{"10", "4", 0, RoundHalfUp, "3"},
+		{"10", "4", 0, RoundHalfEven, "2"},
+		{"-7", "2", 0, RoundHalfUp, "-4"},
+		{"7", "-2", 0, RoundHalfUp, "-4"},
+		{"7", "-2", 0, RoundDown, "-3"},
+		{"0.001", "1000", 2, RoundHalfUp, "0"},
+		{"0.001", "1000", 2, RoundCeil, "0.01"},
+	}
+	for _, tt := range tests {
+		if d := MustDecimal(tt.a).DivRound(MustDecimal(tt.b), tt.places, tt.mode); d.String() != tt.want {
+			t.Errorf("%s / %s places %d mode %d: %s, want %s", tt.a, tt.b, tt.places, tt.mode, d, tt.want)
+		}
+	}
+	if d := MustDecimal("1").Div(MustDecimal("8"), 2); d.String() != "0.13" {
+		t.Errorf("1 / 8: %s, want 0.13", d)
+	}
+}
+
+func TestDecimalDivByZero(t *testing.T) {
+	for _, zero := range []Decimal{{}, MustDecimal("0.000")} {
+		func() {
+			defer func() {
+				if r := recover(); r != "decimal division by zero" {
+					t.Errorf("recovered %v", r)
+				}
+			}()
+			MustDecimal("1").Div(zero, 2)
+		}()
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct {
+		Price Decimal `json:"price"`
+		Qty   Decimal `json:"qty"`
+		Fee   Decimal `json:"fee"`
+	}
+	if err := json.Unmarshal([]byte(`{"price":"19000.50","qty":0.001,"fee":""}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Price.String() != "19000.5" || v.Qty.String() != "0.001" || !v.Fee.IsZero() {
+		t.Fatalf("decoded %s %s %s", v.Price, v.Qty, v.Fee)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"price":"19000.5","qty":"0.001","fee":"0"}` {
+		t.Errorf("encoded %s", s)
+	}
+	if err := json.Unmarshal([]byte(`{"price":"x"}`), &v); err == nil {
+		t.Error("invalid price decoded")
+	}
+}
@@ -1,16 +1,53 @@
 package transport
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
 )
 
+// Error categories, match with errors.Is(err, transport.ErrAuth)
+var (
+	ErrAuth                = errors.New("auth")
+	ErrRateLimit           = errors.New("rate limit")
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrInvalidParam        = errors.New("invalid param")
+	ErrPositionMode        = errors.New("position mode conflict")
+	ErrServer              = errors.New("server") // Bybit replied that it failed or timed out internally
+	ErrTransport           = errors.New("transport")
+	ErrDecode              = errors.New("decode")
+)
+
+// Bybit error code to category
+type CodeMap map[int]error
+
+// Codes shared by all APIs (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-errors)
+var commonCodes = CodeMap{
+	10001: ErrInvalidParam, // Params error
+	10002: ErrAuth,         // Request not authorized (timestamp or recv_window)
+	10003: ErrAuth,         // Invalid API key
+	10004: ErrAuth,         // Invalid sign
+	10005: ErrAuth,         // Permission denied for current API key
+	10006: ErrRateLimit,    // Too many visits
+	10007: ErrServer,       // Response timeout from backend server
+	10010: ErrAuth,         // Unmatched IP
+	10016: ErrServer,       // Service error
+	10017: ErrInvalidParam, // Request path not found or request method is invalid
+	10018: ErrRateLimit,    // Exceeded IP rate limit
+	33004: ErrAuth,         // API key expired
+}
+
 type Error struct {
 	Err
 }
 
 type Err struct {
-	Code int
-	Text string
+	Code     int
+	Text     string
+	Category error // One of Err* categories, nil if the code is not classified
 }
 
 func (o *Err) Empty() bool {
@@ -20,3 +57,75 @@ func (o *Err) Empty() bool {
 func (o *Err) Error() string {
 	return fmt.Sprintf("code[%d]: %s", o.Code, o.Text)
 }
+
+func (o *Err) Is(target error) bool {
+	return o.Category != nil && o.Category == target
+}
+
+// Category by API specific codes, falling back to the common ones
+func (o Err) Classify(codes CodeMap) Err {
+	if c, ok := codes[o.Code]; ok {
+		o.Category = c
+	} else if c, ok := commonCodes[o.Code]; ok {
+		o.Category = c
+	}
+	return o
+}
+
+// Request failed before a Bybit response was received: network error or http status
+type TransportError struct {
+	Status int // Http status code, zero if there was no response
+	Err    error
+}
+
+func (o *TransportError) Error() string {
+	if o.Err == nil {
+		return fmt.Sprintf("http status-code: %d", o.Status)
+	}
+	return o.Err.Error()
+}
+
+func (o *TransportError) Unwrap() error {
+	return o.Err
+}
+
+func (o *TransportError) Is(target error) bool {
+	switch target {
+	case ErrTransport:
+		return true
+	case ErrRateLimit:
+		return o.Status == http.StatusTooManyRequests || o.Status == http.StatusForbidden
+	}
+	return false
+}
+
+// Response body is empty or is not a valid envelope
+type DecodeError struct {
+	Err error
+}
+
+func (o *DecodeError) Error() string {
+	return fmt.Sprintf("decode: %v", o.Err)
+}
+
+func (o *DecodeError) Unwrap() error {
+	return o.Err
+}
+
+func (o *DecodeError) Is(target error) bool {
+	return target == ErrDecode
+}
+
+// Request was aborted because the context deadline (or a network timeout) expired
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr) && nerr.Timeout()
+}
+
+// Request was aborted because the context was canceled
+func IsCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
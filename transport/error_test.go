@@ -0,0 +1,36 @@
+package transport
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrClassify(t *testing.T) {
+	codes := CodeMap{
+		10001:  ErrOrderNotFound,
+		130021: ErrInsufficientBalance,
+	}
+	tests := []struct {
+		code int
+		want error
+	}{
+		{130021, ErrInsufficientBalance},
+		{10001, ErrOrderNotFound},
+		{10004, ErrAuth},
+		{10006, ErrRateLimit},
+		{10016, ErrServer},
+		{99999, nil},
+	}
+	for _, tt := range tests {
+		err := &Error{Err: Err{Code: tt.code}.Classify(codes)}
+		if err.Category != tt.want {
+			t.Errorf("code %d: category %v, want %v", tt.code, err.Category, tt.want)
+		}
+		if tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("code %d: is not %v", tt.code, tt.want)
+		}
+		if errors.Is(err, ErrTransport) {
+			t.Errorf("code %d: exchange reply is a transport error", tt.code)
+		}
+	}
+}
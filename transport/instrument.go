@@ -0,0 +1,223 @@
+package transport
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Trading rules of a symbol. Zero limits and steps are not checked
+type Instrument struct {
+	Symbol      string
+	TickSize    Decimal
+	MinPrice    Decimal
+	MaxPrice    Decimal
+	QtyStep     Decimal
+	MinQty      Decimal
+	MaxQty      Decimal
+	QuoteStep   Decimal // Precision of quote amounts (spot market buy qty)
+	MinNotional Decimal // Order value (price * qty) in quote currency
+	MaxNotional Decimal
+	MinLeverage Decimal
+	MaxLeverage Decimal
+}
+
+// Nearest multiple of the tick size
+func (o Instrument) RoundPrice(price Decimal) Decimal {
+	return price.RoundStep(o.TickSize, RoundHalfUp)
+}
+
+// Quantity rounded down to the qty step
+func (o Instrument) RoundQty(qty Decimal) Decimal {
+	return qty.RoundStep(o.QtyStep, RoundDown)
+}
+
+// Quote amount rounded down to the quote precision
+func (o Instrument) RoundQuote(amount Decimal) Decimal {
+	return amount.RoundStep(o.QuoteStep, RoundDown)
+}
+
+func (o Instrument) CheckPrice(price Decimal) (l []Violation) {
+	return checkRange(l, "price", price, o.MinPrice, o.MaxPrice)
+}
+
+func (o Instrument) CheckQty(qty Decimal) (l []Violation) {
+	if qty.Sign() <= 0 {
+		return append(l, Violation{Field: "qty", Rule: "positive", Value: qty})
+	}
+	return checkRange(l, "qty", qty, o.MinQty, o.MaxQty)
+}
+
+func (o Instrument) CheckNotional(notional Decimal) (l []Violation) {
+	return checkRange(l, "notional", notional, o.MinNotional, o.MaxNotional)
+}
+
+func (o Instrument) CheckLeverage(leverage Decimal) (l []Violation) {
+	return checkRange(l, "leverage", leverage, o.MinLeverage, o.MaxLeverage)
+}
+
+func checkRange(l []Violation, field string, v Decimal, min Decimal, max Decimal) []Violation {
+	if min.IsNotZero() && v.LessThan(min) {
+		l = append(l, Violation{Field: field, Rule: "min", Value: v, Limit: min})
+	}
+	if max.IsNotZero() && v.GreaterThan(max) {
+		l = append(l, Violation{Field: field, Rule: "max", Value: v, Limit: max})
+	}
+	return l
+}
+
+// Broken rule of an order: field (symbol, price, qty, notional, leverage) and rule (unknown, positive, min, max)
+type Violation struct {
+	Field string
+	Rule  string
+	Value Decimal
+	Limit Decimal
+}
+
+func (o Violation) String() string {
+	switch o.Rule {
+	case "unknown":
+		return "unknown symbol"
+	case "positive":
+		return fmt.Sprintf("%s %v must be positive", o.Field, o.Value)
+	case "min":
+		return fmt.Sprintf("%s %v less than min %v", o.Field, o.Value, o.Limit)
+	case "max":
+		return fmt.Sprintf("%s %v greater than max %v", o.Field, o.Value, o.Limit)
+	}
+	return fmt.Sprintf("%s %v: %s %v", o.Field, o.Value, o.Rule, o.Limit)
+}
+
+// Order rejected before sending; matches ErrInvalidParam
+type ValidationError struct {
+	Symbol     string
+	Violations []Violation
+}
+
+func (o *ValidationError) Error() string {
+	l := make([]string, len(o.Violations))
+	for i, v := range o.Violations {
+		l[i] = v.String()
+	}
+	return fmt.Sprintf("%s: %s", o.Symbol, strings.Join(l, "; "))
+}
+
+func (o *ValidationError) Is(target error) bool {
+	return target == ErrInvalidParam
+}
+
+// Instrument registry: loaded on first use and reloaded once older than ttl
+type Instruments struct {
+	mutex   sync.Mutex
+	load    func() ([]Instrument, error)
+	ttl     time.Duration
+	m       map[string]Instrument
+	updated time.Time
+}
+
+func NewInstruments(load func() ([]Instrument, error)) *Instruments {
+	return &Instruments{load: load}
+}
+
+// Reload period; zero loads once (default)
+func (o *Instruments) WithTTL(ttl time.Duration) *Instruments {
+	o.ttl = ttl
+	return o
+}
+
+func (o *Instruments) Refresh() error {
+	l, err := o.load()
+	if err != nil {
+		return err
+	}
+	o.Set(l...)
+	return nil
+}
+
+// Replaces the registry content
+func (o *Instruments) Set(l ...Instrument) {
+	m := make(map[string]Instrument, len(l))
+	for _, v := range l {
+		m[v.Symbol] = v
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.m = m
+	o.updated = time.Now()
+}
+
+func (o *Instruments) expired() bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.m == nil || (o.ttl > 0 && time.Since(o.updated) > o.ttl)
+}
+
+func (o *Instruments) Get(symbol string) (Instrument, error) {
+	if o.expired() {
+		if err := o.Refresh(); err != nil {
+			return Instrument{}, err
+		}
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	v, ok := o.m[symbol]
+	if !ok {
+		return v, &ValidationError{Symbol: symbol, Violations: []Violation{{Field: "symbol", Rule: "unknown"}}}
+	}
+	return v, nil
+}
+
+// Rounds prices to the tick size and qty down to the qty step, then checks the limits.
+// Notional is checked against the first price; nil prices are skipped (market orders)
+func (o *Instruments) PrepareOrder(symbol string, qty *Decimal, prices ...*Decimal) error {
+	v, err := o.Get(symbol)
+	if err != nil {
+		return err
+	}
+	var l []Violation
+	*qty = v.RoundQty(*qty)
+	l = append(l, v.CheckQty(*qty)...)
+	notional := false
+	for _, p := range prices {
+		if p == nil {
+			continue
+		}
+		*p = v.RoundPrice(*p)
+		l = append(l, v.CheckPrice(*p)...)
+		if !notional {
+			l = append(l, v.CheckNotional(p.Mul(*qty))...)
+			notional = true
+		}
+	}
+	return violations(symbol, l)
+}
+
+// Rounds a quote amount (spot market buy) down to the quote precision and checks the notional limits
+func (o *Instruments) PrepareQuote(symbol string, amount *Decimal) error {
+	v, err := o.Get(symbol)
+	if err != nil {
+		return err
+	}
+	*amount = v.RoundQuote(*amount)
+	var l []Violation
+	if amount.Sign() <= 0 {
+		l = append(l, Violation{Field: "notional", Rule: "positive", Value: *amount})
+	}
+	return violations(symbol, append(l, v.CheckNotional(*amount)...))
+}
+
+func (o *Instruments) CheckLeverage(symbol string, leverage Decimal) error {
+	v, err := o.Get(symbol)
+	if err != nil {
+		return err
+	}
+	return violations(symbol, v.CheckLeverage(leverage))
+}
+
+func violations(symbol string, l []Violation) error {
+	if len(l) == 0 {
+		return nil
+	}
+	return &ValidationError{Symbol: symbol, Violations: l}
+}
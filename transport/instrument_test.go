@@ -0,0 +1,182 @@
+package transport
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+var testInstrument = Instrument{
+	Symbol:      "BTCUSDT",
+	TickSize:    MustDecimal("0.5"),
+	MinPrice:    MustDecimal("0.5"),
+	MaxPrice:    MustDecimal("100000"),
+	QtyStep:     MustDecimal("0.001"),
+	MinQty:      MustDecimal("0.001"),
+	MaxQty:      MustDecimal("100"),
+	QuoteStep:   MustDecimal("0.01"),
+	MinNotional: MustDecimal("5"),
+	MaxNotional: MustDecimal("1000000"),
+	MinLeverage: MustDecimal("1"),
+	MaxLeverage: MustDecimal("100"),
+}
+
+func TestInstrumentRound(t *testing.T) {
+	tests := []struct {
+		round func(Decimal) Decimal
+		in    string
+		want  string
+	}{
+		{testInstrument.RoundPrice, "20000.24", "20000"},
+		{testInstrument.RoundPrice, "20000.25", "20000.5"},
+		{testInstrument.RoundPrice, "20000.74", "20000.5"},
+		{testInstrument.RoundPrice, "20000.75", "20001"},
+		{testInstrument.RoundQty, "0.0129", "0.012"},
+		{testInstrument.RoundQty, "0.0009", "0"},
+		{testInstrument.RoundQty, "7", "7"},
+		{testInstrument.RoundQuote, "10.999", "10.99"},
+		// Zero step leaves the value as is
+		{Instrument{}.RoundPrice, "1.23456", "1.23456"},
+		{Instrument{}.RoundQty, "1.23456", "1.23456"},
+	}
+	for _, tt := range tests {
+		if v := tt.round(MustDecimal(tt.in)).String(); v != tt.want {
+			t.Errorf("%s rounded to %s, want %s", tt.in, v, tt.want)
+		}
+	}
+}
+
+func TestInstrumentsPrepareOrder(t *testing.T) {
+	r := NewInstruments(func() ([]Instrument, error) {
+		return []Instrument{testInstrument}, nil
+	})
+	price := func(s string) *Decimal {
+		v := MustDecimal(s)
+		return &v
+	}
+	tests := []struct {
+		name       string
+		symbol     string
+		qty        string
+		prices     []*Decimal
+		wantQty    string
+		wantPrices []string
+		violations []string // field:rule
+	}{
+		{"valid", "BTCUSDT", "0.0105", []*Decimal{price("20000.3")}, "0.01", []string{"20000.5"}, nil},
+		{"market", "BTCUSDT", "0.01", []*Decimal{nil}, "0.01", []string{""}, nil},
+		{"unknown symbol", "ETHUSDT", "1", nil, "1", nil, []string{"symbol:unknown"}},
+		{"qty rounded to zero", "BTCUSDT", "0.0009", nil, "0", nil, []string{"qty:positive"}},
+		{"qty max", "BTCUSDT", "101", nil, "101", nil, []string{"qty:max"}},
+		{"price range", "BTCUSDT", "1", []*Decimal{price("0.1"), price("200000")}, "1", []string{"0", "200000"},
+			[]string{"price:min", "notional:min", "price:max"}},
+		{"notional of first price", "BTCUSDT", "0.001", []*Decimal{price("1000"), price("20000")}, "0.001", []string{"1000", "20000"},
+			[]string{"notional:min"}},
+		{"notional max", "BTCUSDT", "100", []*Decimal{price("20000")}, "100", []string{"20000"}, []string{"notional:max"}},
+		{"aggregated", "BTCUSDT", "200", []*Decimal{price("100001")}, "200", []string{"100001"},
+			[]string{"qty:max", "price:max", "notional:max"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qty := MustDecimal(tt.qty)
+			err := r.PrepareOrder(tt.symbol, &qty, tt.prices...)
+			if qty.String() != tt.wantQty {
+				t.Errorf("qty %s, want %s", qty, tt.wantQty)
+			}
+			for i, p := range tt.prices {
+				if p != nil && p.String() != tt.wantPrices[i] {
+					t.Errorf("price %d: %s, want %s", i, p, tt.wantPrices[i])
+				}
+			}
+			checkViolations(t, err, tt.symbol, tt.violations)
+		})
+	}
+}
+
+func TestInstrumentsChecks(t *testing.T) {
+	r := NewInstruments(func() ([]Instrument, error) {
+		return []Instrument{testInstrument}, nil
+	})
+	tests := []struct {
+		name       string
+		check      func() error
+		violations []string
+	}{
+		{"quote", func() error {
+			v := MustDecimal("10.999")
+			err := r.PrepareQuote("BTCUSDT", &v)
+			if v.String() != "10.99" {
+				t.Errorf("quote %s, want 10.99", v)
+			}
+			return err
+		}, nil},
+		{"quote min", func() error {
+			v := MustDecimal("4.999")
+			return r.PrepareQuote("BTCUSDT", &v)
+		}, []string{"notional:min"}},
+		{"quote zero", func() error {
+			v := MustDecimal("0.001")
+			return r.PrepareQuote("BTCUSDT", &v)
+		}, []string{"notional:positive", "notional:min"}},
+		{"leverage", func() error { return r.CheckLeverage("BTCUSDT", MustDecimal("10")) }, nil},
+		{"leverage min", func() error { return r.CheckLeverage("BTCUSDT", MustDecimal("0.5")) }, []string{"leverage:min"}},
+		{"leverage max", func() error { return r.CheckLeverage("BTCUSDT", MustDecimal("125")) }, []string{"leverage:max"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkViolations(t, tt.check(), "BTCUSDT", tt.violations)
+		})
+	}
+
+	// No limits, nothing to check
+	none := Instrument{}
+	if l := none.CheckQty(MustDecimal("1000000")); len(l) != 0 {
+		t.Errorf("unlimited qty: %v", l)
+	}
+	if l := none.CheckPrice(MustDecimal("-1")); len(l) != 0 {
+		t.Errorf("unlimited price: %v", l)
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	err := error(&ValidationError{Symbol: "BTCUSDT", Violations: []Violation{
+		{Field: "qty", Rule: "positive", Value: MustDecimal("0")},
+		{Field: "price", Rule: "min", Value: MustDecimal("0.1"), Limit: MustDecimal("0.5")},
+		{Field: "notional", Rule: "max", Value: MustDecimal("2000000"), Limit: MustDecimal("1000000")},
+	}})
+	want := "BTCUSDT: qty 0 must be positive; price 0.1 less than min 0.5; notional 2000000 greater than max 1000000"
+	if err.Error() != want {
+		t.Errorf("error %q, want %q", err, want)
+	}
+	if !errors.Is(err, ErrInvalidParam) {
+		t.Error("not ErrInvalidParam")
+	}
+	unknown := &ValidationError{Symbol: "X", Violations: []Violation{{Field: "symbol", Rule: "unknown"}}}
+	if unknown.Error() != "X: unknown symbol" {
+		t.Errorf("error %q", unknown)
+	}
+}
+
+func checkViolations(t *testing.T, err error, symbol string, want []string) {
+	t.Helper()
+	if len(want) == 0 {
+		if err != nil {
+			t.Errorf("error %v", err)
+		}
+		return
+	}
+	var v *ValidationError
+	if !errors.As(err, &v) {
+		t.Fatalf("error %v, want validation error", err)
+	}
+	if !errors.Is(err, ErrInvalidParam) {
+		t.Errorf("%v is not ErrInvalidParam", err)
+	}
+	l := make([]string, len(v.Violations))
+	for i, x := range v.Violations {
+		l[i] = x.Field + ":" + x.Rule
+	}
+	if v.Symbol != symbol || strings.Join(l, ",") != strings.Join(want, ",") {
+		t.Errorf("%s %v, want %s %v", v.Symbol, l, symbol, want)
+	}
+}
@@ -0,0 +1,85 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/msw-x/moon/ulog"
+)
+
+// One attempt of a REST request passing through the interceptor chain.
+// Request is signed and ready to send: headers may be added, signed params must not be changed.
+// Response, ResponseBody and Latency are set once the next handler returns
+type Exchange struct {
+	Method       string
+	Path         string
+	Signed       bool
+	Attempt      int
+	Time         time.Time
+	Request      *http.Request
+	RequestBody  []byte
+	Response     *http.Response
+	ResponseBody []byte
+	Latency      time.Duration
+}
+
+type Handler func(x *Exchange) error
+
+// Wraps the exchange: code before next runs on the request, code after next on the response.
+// Returning without calling next short-circuits the request
+type Interceptor func(x *Exchange, next Handler) error
+
+// Handler calling the interceptors in order (first is outermost) around h
+func Chain(h Handler, interceptors ...Interceptor) Handler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		h = wrap(interceptors[i], h)
+	}
+	return h
+}
+
+func wrap(i Interceptor, next Handler) Handler {
+	return func(x *Exchange) error {
+		return i(x, next)
+	}
+}
+
+// Logs request uri and response body to debug level
+func LogInterceptor(log *ulog.Log, uri bool, response bool) Interceptor {
+	return func(x *Exchange, next Handler) error {
+		if uri {
+			log.Debug("uri:", x.Request.URL.String())
+		}
+		err := next(x)
+		if response && err == nil {
+			log.Debug("response:", string(x.ResponseBody))
+		}
+		return err
+	}
+}
+
+// Sets the header on every request
+func HeaderInterceptor(key string, value string) Interceptor {
+	return func(x *Exchange, next Handler) error {
+		x.Request.Header.Set(key, value)
+		return next(x)
+	}
+}
+
+func roundTrip(client *http.Client) Handler {
+	return func(x *Exchange) error {
+		resp, err := client.Do(x.Request)
+		x.Latency = time.Since(x.Time)
+		if err != nil {
+			return &TransportError{Err: err}
+		}
+		defer resp.Body.Close()
+		x.Response = resp
+		x.ResponseBody, err = io.ReadAll(resp.Body)
+		x.Latency = time.Since(x.Time)
+		if err != nil {
+			return &TransportError{Status: resp.StatusCode, Err: err}
+		}
+		return nil
+	}
+}
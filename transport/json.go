@@ -8,13 +8,13 @@ import (
 	"time"
 )
 
-func JsonUnmarshal[V any](jsonBlob []byte) V {
+func JsonUnmarshal[V any](jsonBlob []byte) (V, error) {
 	var v V
 	err := json.Unmarshal(jsonBlob, &v)
 	if err != nil {
-		panic(fmt.Sprint("json unmarshal: ", err))
+		return v, &DecodeError{Err: err}
 	}
-	return v
+	return v, nil
 }
 
 type Float64 float64
@@ -48,16 +48,103 @@ func (o Float64) IsNotZero() bool {
 	return o.Value() != 0
 }
 
+// Epoch time: seconds, milliseconds, microseconds or nanoseconds (detected by magnitude),
+// fractional seconds ("1577480599.123") and RFC3339 strings. Encoded as milliseconds
 type Timestamp time.Time
 
 func (o *Timestamp) UnmarshalJSON(b []byte) error {
-	// convert uint timestamp to time.Time
-	return nil
+	t, err := parseTime(b)
+	*o = Timestamp(t)
+	return err
+}
+
+func (o Timestamp) MarshalJSON() ([]byte, error) {
+	if o.IsZero() {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(o.Time().UnixMilli(), 10)), nil
+}
+
+func (o Timestamp) Time() time.Time {
+	return time.Time(o)
+}
+
+func (o Timestamp) IsZero() bool {
+	return o.Time().IsZero()
 }
 
+func (o Timestamp) String() string {
+	return o.Time().String()
+}
+
+// RFC3339 time; epoch values are accepted as by Timestamp. Encoded as RFC3339
 type Time time.Time
 
 func (o *Time) UnmarshalJSON(b []byte) error {
-	// convert tim to time.Time
-	return nil
+	t, err := parseTime(b)
+	*o = Time(t)
+	return err
+}
+
+func (o Time) MarshalJSON() ([]byte, error) {
+	if o.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(o.Time().UTC().Format(time.RFC3339Nano))
+}
+
+func (o Time) Time() time.Time {
+	return time.Time(o)
+}
+
+func (o Time) IsZero() bool {
+	return o.Time().IsZero()
+}
+
+func (o Time) String() string {
+	return o.Time().String()
+}
+
+// Empty string, null and zero give zero time
+func parseTime(b []byte) (time.Time, error) {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" || s == "0" {
+		return time.Time{}, nil
+	}
+	if strings.ContainsAny(strings.TrimPrefix(s, "-"), "-:TZ") {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			t, err = time.Parse("2006-01-02 15:04:05", s)
+		}
+		return t, err
+	}
+	return parseEpoch(s)
+}
+
+func parseEpoch(s string) (time.Time, error) {
+	whole, frac, _ := strings.Cut(s, ".")
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse time %q: %v", s, err)
+	}
+	digits := len(strings.TrimPrefix(whole, "-"))
+	switch {
+	case digits <= 11:
+		var nsec int64
+		if frac != "" {
+			frac = (frac + "000000000")[:9]
+			if nsec, err = strconv.ParseInt(frac, 10, 64); err != nil {
+				return time.Time{}, fmt.Errorf("parse time %q: %v", s, err)
+			}
+			if strings.HasPrefix(whole, "-") {
+				nsec = -nsec
+			}
+		}
+		return time.Unix(n, nsec), nil
+	case digits <= 14:
+		return time.UnixMilli(n), nil
+	case digits <= 17:
+		return time.UnixMicro(n), nil
+	}
+	return time.Unix(0, n), nil
 }
@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	sec := time.Date(2022, 10, 1, 12, 30, 15, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{`""`, time.Time{}},
+		{`null`, time.Time{}},
+		{`0`, time.Time{}},
+		{`"0"`, time.Time{}},
+		{`1664627415`, sec},
+		{`"1664627415"`, sec},
+		{`1664627415123`, sec.Add(123 * time.Millisecond)},
+		{`1664627415123456`, sec.Add(123456 * time.Microsecond)},
+		{`1664627415123456789`, sec.Add(123456789)},
+		{`"1664627415.5"`, sec.Add(500 * time.Millisecond)},
+		{`1664627415.123456`, sec.Add(123456 * time.Microsecond)},
+		{`"1664627415.1234567891"`, sec.Add(123456789)},
+		{`1`, time.Unix(1, 0)},
+		{`-1`, time.Unix(-1, 0)},
+		{`"-1.5"`, time.Unix(-2, 500000000)},
+		{`-1000000000123`, time.UnixMilli(-1000000000123)},
+		{`"2022-10-01T12:30:15Z"`, sec},
+		{`"2022-10-01T12:30:15.123Z"`, sec.Add(123 * time.Millisecond)},
+		{`"2022-10-01T15:30:15+03:00"`, sec},
+		{`"1969-12-31T23:59:59Z"`, time.Unix(-1, 0)},
+		{`"2022-10-01 12:30:15"`, sec},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v, err := parseTime([]byte(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !v.Equal(tt.want) {
+				t.Errorf("%s, want %s", v.UTC(), tt.want.UTC())
+			}
+		})
+	}
+	for _, s := range []string{`"x"`, `"12:30"`, `"2022-10-01"`, `"1664627415.x"`, `"1e9"`} {
+		if _, err := parseTime([]byte(s)); err == nil {
+			t.Errorf("%s: no error", s)
+		}
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	var v struct {
+		T Timestamp `json:"t"`
+		R Time      `json:"r"`
+	}
+	if err := json.Unmarshal([]byte(`{"t":"1664627415","r":1664627415123}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"t":1664627415000,"r":"2022-10-01T12:30:15.123Z"}`; string(b) != want {
+		t.Errorf("json %s, want %s", b, want)
+	}
+	v.T, v.R = Timestamp{}, Time{}
+	if b, _ = json.Marshal(v); string(b) != `{"t":0,"r":""}` {
+		t.Errorf("zero json %s", b)
+	}
+}
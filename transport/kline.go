@@ -0,0 +1,226 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Candlestick of any market; zero fields are not provided by the endpoint
+type Bar struct {
+	Time     time.Time // Open time
+	Open     Decimal
+	High     Decimal
+	Low      Decimal
+	Close    Decimal
+	Volume   Decimal
+	Turnover Decimal
+}
+
+// Missing bars in [From, To)
+type Gap struct {
+	From time.Time
+	To   time.Time
+}
+
+func (o Gap) String() string {
+	return fmt.Sprintf("[%s, %s)", o.From.UTC().Format(time.RFC3339), o.To.UTC().Format(time.RFC3339))
+}
+
+// Requests at most limit bars opening in [start, end), in any order
+type KlineFetch func(ctx context.Context, start time.Time, end time.Time, limit int) ([]Bar, error)
+
+// Backfills any range of bars by pages of limit bars, resuming from already downloaded bars:
+//
+//	bars, _ := ReadCSV(f)
+//	d := req.Download(client)
+//	bars, err := d.Download(start, end, bars)
+//	gaps := d.Gaps()
+type KlineDownloader struct {
+	ctx      context.Context
+	fetch    KlineFetch
+	interval time.Duration
+	limit    int
+	pause    time.Duration
+	attempts int
+	progress func(last time.Time)
+	gaps     []Gap
+}
+
+func NewKlineDownloader(ctx context.Context, interval time.Duration, limit int, fetch KlineFetch) *KlineDownloader {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &KlineDownloader{
+		ctx:      ctx,
+		fetch:    fetch,
+		interval: interval,
+		limit:    limit,
+		attempts: 5,
+	}
+}
+
+// Delay between requests; the client limiter (if any) applies as well
+func (o *KlineDownloader) WithPause(pause time.Duration) *KlineDownloader {
+	o.pause = pause
+	return o
+}
+
+// Attempts of a page rejected by rate limit, with growing delay
+func (o *KlineDownloader) WithAttempts(attempts int) *KlineDownloader {
+	o.attempts = attempts
+	return o
+}
+
+// Called with open time of the last bar of each page
+func (o *KlineDownloader) WithProgress(f func(last time.Time)) *KlineDownloader {
+	o.progress = f
+	return o
+}
+
+// Bars opening in [start, end) merged with have, sorted and deduplicated.
+// Only ranges missing in have are requested, so an interrupted download is resumed
+// by passing the bars it returned (or saved) before the error. The end is clamped
+// to the open time of the current bar: it is not closed yet, so it is neither
+// downloaded nor reported as a gap
+func (o *KlineDownloader) Download(start time.Time, end time.Time, have []Bar) ([]Bar, error) {
+	if o.interval <= 0 {
+		return have, fmt.Errorf("%w: kline interval has no fixed duration", ErrInvalidParam)
+	}
+	if o.limit <= 0 {
+		return have, fmt.Errorf("%w: kline limit %d", ErrInvalidParam, o.limit)
+	}
+	start = start.Truncate(o.interval)
+	if open := time.Now().Truncate(o.interval); end.After(open) {
+		end = open
+	}
+	bars := MergeBars(have)
+	var err error
+	for _, gap := range FindGaps(bars, o.interval, start, end) {
+		var l []Bar
+		l, err = o.fill(gap)
+		bars = MergeBars(bars, l)
+		if err != nil {
+			break
+		}
+	}
+	o.gaps = FindGaps(bars, o.interval, start, end)
+	return bars, err
+}
+
+// Bars missing after the last download: the exchange has no data for them
+func (o *KlineDownloader) Gaps() []Gap {
+	return o.gaps
+}
+
+func (o *KlineDownloader) fill(gap Gap) (bars []Bar, err error) {
+	page := o.interval * time.Duration(o.limit)
+	for t := gap.From; t.Before(gap.To); {
+		end := t.Add(page)
+		if end.After(gap.To) {
+			end = gap.To
+		}
+		var l []Bar
+		l, err = o.page(t, end)
+		if err != nil {
+			return
+		}
+		next := end
+		for _, v := range l {
+			if !v.Time.Before(t) && v.Time.Before(gap.To) {
+				bars = append(bars, v)
+				if after := v.Time.Add(o.interval); after.After(next) {
+					next = after
+				}
+			}
+		}
+		if o.progress != nil && len(bars) > 0 {
+			o.progress(bars[len(bars)-1].Time)
+		}
+		t = next
+	}
+	return
+}
+
+func (o *KlineDownloader) page(start time.Time, end time.Time) ([]Bar, error) {
+	delay := o.pause
+	for attempt := 1; ; attempt++ {
+		if delay > 0 {
+			if err := sleep(o.ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+		if err := o.ctx.Err(); err != nil {
+			return nil, err
+		}
+		l, err := o.fetch(o.ctx, start, end, o.limit)
+		if err == nil || !errors.Is(err, ErrRateLimit) || attempt >= o.attempts {
+			return l, err
+		}
+		delay = time.Second << (attempt - 1)
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// Bars of all lists sorted by time; of bars with the same time the last one is kept
+func MergeBars(lists ...[]Bar) []Bar {
+	var l []Bar
+	for _, v := range lists {
+		l = append(l, v...)
+	}
+	sort.SliceStable(l, func(i, j int) bool {
+		return l[i].Time.Before(l[j].Time)
+	})
+	n := 0
+	for i, v := range l {
+		if n > 0 && l[n-1].Time.Equal(v.Time) {
+			l[n-1] = v
+			continue
+		}
+		l[n] = l[i]
+		n++
+	}
+	return l[:n]
+}
+
+// Ranges of [start, end) without bars; bars must be sorted
+func FindGaps(bars []Bar, interval time.Duration, start time.Time, end time.Time) (l []Gap) {
+	t := start
+	for _, v := range bars {
+		if v.Time.Before(t) {
+			continue
+		}
+		if !v.Time.Before(end) {
+			break
+		}
+		if v.Time.After(t) {
+			l = append(l, Gap{From: t, To: v.Time})
+		}
+		t = v.Time.Add(interval)
+	}
+	if t.Before(end) {
+		l = append(l, Gap{From: t, To: end})
+	}
+	return
+}
+
+// Bars of endpoint specific kline items
+func Bars[T interface{ Bar() Bar }](l []T) []Bar {
+	bars := make([]Bar, len(l))
+	for i, v := range l {
+		bars[i] = v.Bar()
+	}
+	return bars
+}
@@ -0,0 +1,64 @@
+package transport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+)
+
+var barColumns = []string{"time", "open", "high", "low", "close", "volume", "turnover"}
+
+// Writes header and bars; time is RFC3339 UTC, numbers are exact decimals
+func WriteCSV(w io.Writer, bars []Bar) error {
+	c := csv.NewWriter(w)
+	if err := c.Write(barColumns); err != nil {
+		return err
+	}
+	for _, v := range bars {
+		err := c.Write([]string{
+			v.Time.UTC().Format(time.RFC3339),
+			v.Open.String(),
+			v.High.String(),
+			v.Low.String(),
+			v.Close.String(),
+			v.Volume.String(),
+			v.Turnover.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	c.Flush()
+	return c.Error()
+}
+
+// Reads bars written by WriteCSV; time may also be epoch seconds or milliseconds
+func ReadCSV(r io.Reader) ([]Bar, error) {
+	c := csv.NewReader(r)
+	c.FieldsPerRecord = len(barColumns)
+	rows, err := c.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var l []Bar
+	for i, row := range rows {
+		if i == 0 && row[0] == barColumns[0] {
+			continue
+		}
+		var v Bar
+		if v.Time, err = parseTime([]byte(row[0])); err != nil {
+			return nil, fmt.Errorf("csv line %d: %v", i+1, err)
+		}
+		for n, d := range []*Decimal{&v.Open, &v.High, &v.Low, &v.Close, &v.Volume, &v.Turnover} {
+			if row[n+1] == "" {
+				continue
+			}
+			if *d, err = ParseDecimal(row[n+1]); err != nil {
+				return nil, fmt.Errorf("csv line %d: %v", i+1, err)
+			}
+		}
+		l = append(l, v)
+	}
+	return l, nil
+}
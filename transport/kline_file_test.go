@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteCSV(t *testing.T) {
+	v := testBar(0, "19000")
+	v.Time = v.Time.In(time.FixedZone("MSK", 3*60*60))
+	var b bytes.Buffer
+	if err := WriteCSV(&b, []Bar{v}); err != nil {
+		t.Fatal(err)
+	}
+	want := "time,open,high,low,close,volume,turnover\n" +
+		"2022-10-01T00:00:00Z,19000,19001.5,18999.75,19000,0.001,19.5\n"
+	if b.String() != want {
+		t.Errorf("csv:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	bars := []Bar{testBar(0, "19000.5"), testBar(1, "0.00001234"), {Time: minute(2)}}
+	var b bytes.Buffer
+	if err := WriteCSV(&b, bars); err != nil {
+		t.Fatal(err)
+	}
+	l, err := ReadCSV(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != len(bars) {
+		t.Fatalf("%d bars, want %d", len(l), len(bars))
+	}
+	for i, v := range l {
+		if !sameBar(v, bars[i]) {
+			t.Errorf("bar %d: %+v, want %+v", i, v, bars[i])
+		}
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	l, err := ReadCSV(strings.NewReader("1664582400,1,2,0.5,1.5,10,\n1664582460000,1.5,2,1,2,5,7.5\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != 2 {
+		t.Fatalf("%d bars, want 2", len(l))
+	}
+	if !l[0].Time.Equal(minute(0)) || !l[1].Time.Equal(minute(1)) {
+		t.Errorf("times %s %s", l[0].Time, l[1].Time)
+	}
+	if !l[0].Turnover.IsZero() || l[1].Turnover.String() != "7.5" {
+		t.Errorf("turnover %s %s", l[0].Turnover, l[1].Turnover)
+	}
+	for _, s := range []string{
+		"x,1,2,0.5,1.5,10,0\n",
+		"1664582400,1,2,x,1.5,10,0\n",
+		"1664582400,1,2\n",
+	} {
+		if _, err := ReadCSV(strings.NewReader(s)); err == nil {
+			t.Errorf("%q: no error", s)
+		}
+	}
+}
+
+func sameBar(a, b Bar) bool {
+	return a.Time.Equal(b.Time) && a.Open.Equal(b.Open) && a.High.Equal(b.High) && a.Low.Equal(b.Low) &&
+		a.Close.Equal(b.Close) && a.Volume.Equal(b.Volume) && a.Turnover.Equal(b.Turnover)
+}
@@ -0,0 +1,134 @@
+package transport
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testDay = time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
+func minute(n int) time.Time {
+	return testDay.Add(time.Duration(n) * time.Minute)
+}
+
+func testBar(n int, price string) Bar {
+	p := MustDecimal(price)
+	return Bar{
+		Time:     minute(n),
+		Open:     p,
+		High:     p.Add(MustDecimal("1.5")),
+		Low:      p.Sub(MustDecimal("0.25")),
+		Close:    p,
+		Volume:   MustDecimal("0.001"),
+		Turnover: MustDecimal("19.5"),
+	}
+}
+
+func testBars(minutes ...int) []Bar {
+	l := make([]Bar, len(minutes))
+	for i, n := range minutes {
+		l[i] = testBar(n, "19000")
+	}
+	return l
+}
+
+func TestMergeBars(t *testing.T) {
+	l := MergeBars(
+		[]Bar{testBar(0, "1"), testBar(2, "1")},
+		[]Bar{testBar(1, "1"), testBar(0, "2")},
+	)
+	if len(l) != 3 {
+		t.Fatalf("%d bars, want 3", len(l))
+	}
+	for i, v := range l {
+		if !v.Time.Equal(minute(i)) {
+			t.Errorf("bar %d at %s", i, v.Time)
+		}
+	}
+	if l[0].Open.String() != "2" {
+		t.Errorf("duplicate bar open %s, want the last one", l[0].Open)
+	}
+	if l := MergeBars(); len(l) != 0 {
+		t.Errorf("%d bars of nothing", len(l))
+	}
+}
+
+func TestFindGaps(t *testing.T) {
+	tests := []struct {
+		name       string
+		bars       []Bar
+		start, end int
+		want       []Gap
+	}{
+		{"no bars", nil, 0, 5, []Gap{{minute(0), minute(5)}}},
+		{"complete", testBars(0, 1, 2, 3, 4), 0, 5, nil},
+		{"middle and tail", testBars(0, 1, 3), 0, 5, []Gap{{minute(2), minute(3)}, {minute(4), minute(5)}}},
+		{"head and tail", testBars(2), 0, 5, []Gap{{minute(0), minute(2)}, {minute(3), minute(5)}}},
+		{"last bar", testBars(4), 0, 5, []Gap{{minute(0), minute(4)}}},
+		{"bars outside range", testBars(0, 1, 2, 3, 4), 1, 3, nil},
+		{"empty range", testBars(0), 2, 2, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := FindGaps(tt.bars, time.Minute, minute(tt.start), minute(tt.end))
+			if !reflect.DeepEqual(l, tt.want) {
+				t.Errorf("gaps %v, want %v", l, tt.want)
+			}
+		})
+	}
+	if s := (Gap{minute(2), minute(3)}).String(); s != "[2022-10-01T00:02:00Z, 2022-10-01T00:03:00Z)" {
+		t.Errorf("gap string %s", s)
+	}
+}
+
+func TestKlineDownloaderResume(t *testing.T) {
+	var pages []Gap
+	fetch := func(ctx context.Context, start time.Time, end time.Time, limit int) (l []Bar, err error) {
+		pages = append(pages, Gap{start, end})
+		for at := start; at.Before(end); at = at.Add(time.Minute) {
+			// The exchange has no bar of minute 3
+			if !at.Equal(minute(3)) {
+				l = append(l, Bar{Time: at})
+			}
+		}
+		return
+	}
+	d := NewKlineDownloader(context.Background(), time.Minute, 2, fetch)
+	bars, err := d.Download(minute(0), minute(6), testBars(0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Gap{{minute(2), minute(4)}, {minute(4), minute(6)}}; !reflect.DeepEqual(pages, want) {
+		t.Errorf("pages %v, want %v", pages, want)
+	}
+	if len(bars) != 5 {
+		t.Errorf("%d bars, want 5", len(bars))
+	}
+	if want := []Gap{{minute(3), minute(4)}}; !reflect.DeepEqual(d.Gaps(), want) {
+		t.Errorf("gaps %v, want %v", d.Gaps(), want)
+	}
+}
+
+func TestKlineDownloaderOpenBar(t *testing.T) {
+	open := time.Now().Truncate(time.Hour)
+	fetch := func(ctx context.Context, start time.Time, end time.Time, limit int) (l []Bar, err error) {
+		// The exchange returns the current bar as well
+		for at := start; !at.After(open); at = at.Add(time.Hour) {
+			l = append(l, Bar{Time: at})
+		}
+		return
+	}
+	d := NewKlineDownloader(context.Background(), time.Hour, 10, fetch)
+	bars, err := d.Download(open.Add(-3*time.Hour), time.Now(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bars) != 3 || !bars[2].Time.Equal(open.Add(-time.Hour)) {
+		t.Errorf("bars %v, want 3 closed ones", bars)
+	}
+	if len(d.Gaps()) != 0 {
+		t.Errorf("gaps %v", d.Gaps())
+	}
+}
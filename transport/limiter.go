@@ -0,0 +1,193 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrRateLimited = fmt.Errorf("%w exceeded", ErrRateLimit)
+
+type LimitGroup string
+
+const (
+	LimitOrder      LimitGroup = "order"       // Create, cancel, replace orders
+	LimitOrderQuery LimitGroup = "order-query" // Query orders and executions
+	LimitPosition   LimitGroup = "position"    // Position, leverage, tp/sl
+	LimitMarket     LimitGroup = "market"      // Public market data (per IP)
+	LimitAccount    LimitGroup = "account"     // Other private endpoints
+)
+
+type Limit struct {
+	Count  int
+	Period time.Duration
+}
+
+// Bybit documented limits (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-ratelimits)
+func DefaultLimits() map[LimitGroup]Limit {
+	return map[LimitGroup]Limit{
+		LimitOrder:      {Count: 100, Period: time.Minute},
+		LimitOrderQuery: {Count: 600, Period: time.Minute},
+		LimitPosition:   {Count: 75, Period: time.Minute},
+		LimitMarket:     {Count: 50, Period: time.Second},
+		LimitAccount:    {Count: 120, Period: time.Minute},
+	}
+}
+
+type LimitMode int
+
+const (
+	LimitWait     LimitMode = iota // Block until a token is available or the context is done
+	LimitFailFast                  // Return ErrRateLimited immediately
+)
+
+// Token bucket limiter keyed by endpoint group. Private limits are counted per endpoint,
+// market data limit is shared. Buckets are corrected by X-Bapi-Limit* response headers
+type Limiter struct {
+	mutex   sync.Mutex
+	mode    LimitMode
+	limits  map[LimitGroup]Limit
+	buckets map[string]*limitBucket
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		limits:  DefaultLimits(),
+		buckets: make(map[string]*limitBucket),
+	}
+}
+
+func (o *Limiter) WithMode(mode LimitMode) *Limiter {
+	o.mode = mode
+	return o
+}
+
+func (o *Limiter) WithLimit(group LimitGroup, limit Limit) *Limiter {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.limits[group] = limit
+	for _, b := range o.buckets {
+		if b.group == group {
+			b.setLimit(limit)
+		}
+	}
+	return o
+}
+
+func (o *Limiter) Wait(ctx context.Context, group LimitGroup, path string) error {
+	for {
+		o.mutex.Lock()
+		delay := o.bucket(group, path).take(time.Now())
+		o.mutex.Unlock()
+		if delay == 0 {
+			return nil
+		}
+		if o.mode == LimitFailFast {
+			return ErrRateLimited
+		}
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Apply X-Bapi-Limit, X-Bapi-Limit-Status, X-Bapi-Limit-Reset-Timestamp headers
+func (o *Limiter) Update(group LimitGroup, path string, h http.Header) {
+	remaining, err := strconv.Atoi(h.Get("X-Bapi-Limit-Status"))
+	if err != nil {
+		return
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	b := o.bucket(group, path)
+	if limit, err := strconv.Atoi(h.Get("X-Bapi-Limit")); err == nil && limit > 0 && float64(limit) != b.capacity {
+		// Announced limit counts in the period of the seeded one
+		b.setLimit(Limit{Count: limit, Period: b.period})
+	}
+	if float64(remaining) < b.tokens {
+		b.tokens = float64(remaining)
+	}
+	if remaining == 0 {
+		if reset, err := strconv.ParseInt(h.Get("X-Bapi-Limit-Reset-Timestamp"), 10, 64); err == nil {
+			b.resetAt = time.UnixMilli(reset)
+		}
+	}
+}
+
+func (o *Limiter) bucket(group LimitGroup, path string) *limitBucket {
+	key := string(group)
+	if group != LimitMarket {
+		key += ":" + path
+	}
+	b, ok := o.buckets[key]
+	if !ok {
+		b = &limitBucket{group: group}
+		b.setLimit(o.limits[group])
+		b.tokens = b.capacity
+		b.last = time.Now()
+		o.buckets[key] = b
+	}
+	return b
+}
+
+type limitBucket struct {
+	group    LimitGroup
+	capacity float64
+	period   time.Duration
+	rate     float64 // tokens per second
+	tokens   float64
+	last     time.Time
+	resetAt  time.Time
+}
+
+func (o *limitBucket) setLimit(limit Limit) {
+	o.capacity = float64(limit.Count)
+	o.period = limit.Period
+	o.rate = 0
+	if limit.Period > 0 {
+		o.rate = o.capacity / limit.Period.Seconds()
+	}
+}
+
+// Take a token: returns zero or the delay after which a token will be available
+func (o *limitBucket) take(now time.Time) time.Duration {
+	if o.rate <= 0 {
+		return 0
+	}
+	if now.Before(o.resetAt) && o.tokens < 1 {
+		return o.resetAt.Sub(now)
+	}
+	o.tokens += now.Sub(o.last).Seconds() * o.rate
+	if o.tokens > o.capacity {
+		o.tokens = o.capacity
+	}
+	o.last = now
+	if o.tokens >= 1 {
+		o.tokens--
+		return 0
+	}
+	return time.Duration((1 - o.tokens) / o.rate * float64(time.Second))
+}
+
+func limitGroup(method string, path string, sign bool) LimitGroup {
+	switch {
+	case !sign || strings.Contains(path, "public") || strings.Contains(path, "quote"):
+		return LimitMarket
+	case strings.Contains(path, "order"):
+		if method == http.MethodGet {
+			return LimitOrderQuery
+		}
+		return LimitOrder
+	case strings.Contains(path, "position") || strings.Contains(path, "leverage") || strings.Contains(path, "trading-stop") || strings.Contains(path, "tpsl"):
+		return LimitPosition
+	}
+	return LimitAccount
+}
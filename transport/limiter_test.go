@@ -0,0 +1,125 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLimitBucketTake(t *testing.T) {
+	now := time.Now()
+	b := &limitBucket{last: now}
+	b.setLimit(Limit{Count: 2, Period: time.Second})
+	b.tokens = b.capacity
+	steps := []struct {
+		after time.Duration
+		delay time.Duration
+	}{
+		{0, 0},
+		{0, 0},
+		{0, 500 * time.Millisecond},
+		{250 * time.Millisecond, 250 * time.Millisecond},
+		{500 * time.Millisecond, 0},
+		{500 * time.Millisecond, 500 * time.Millisecond},
+		// Idle time refills no more than the capacity
+		{time.Hour, 0},
+		{time.Hour, 0},
+		{time.Hour, 500 * time.Millisecond},
+	}
+	for i, s := range steps {
+		if d := b.take(now.Add(s.after)); d != s.delay {
+			t.Errorf("take %d at +%s: delay %s, want %s", i, s.after, d, s.delay)
+		}
+	}
+
+	unlimited := &limitBucket{}
+	unlimited.setLimit(Limit{Count: 1})
+	for i := 0; i < 3; i++ {
+		if d := unlimited.take(now); d != 0 {
+			t.Errorf("no period: delay %s", d)
+		}
+	}
+}
+
+func TestLimiterFailFast(t *testing.T) {
+	l := NewLimiter().WithMode(LimitFailFast).WithLimit(LimitOrder, Limit{Count: 1, Period: time.Hour})
+	ctx := context.Background()
+	if err := l.Wait(ctx, LimitOrder, "/private/order/create"); err != nil {
+		t.Fatal(err)
+	}
+	err := l.Wait(ctx, LimitOrder, "/private/order/create")
+	if !errors.Is(err, ErrRateLimited) || !errors.Is(err, ErrRateLimit) {
+		t.Errorf("error %v, want rate limited", err)
+	}
+	// Private limits are counted per endpoint
+	if err := l.Wait(ctx, LimitOrder, "/private/order/cancel"); err != nil {
+		t.Errorf("other endpoint: %v", err)
+	}
+}
+
+func TestLimiterWait(t *testing.T) {
+	l := NewLimiter().WithLimit(LimitMarket, Limit{Count: 1, Period: 50 * time.Millisecond})
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		// Market data limit is shared by all paths
+		if err := l.Wait(ctx, LimitMarket, "/public/"+strconv.Itoa(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(start); d < 90*time.Millisecond {
+		t.Errorf("3 requests at 1 per 50ms took %s", d)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	l.WithLimit(LimitMarket, Limit{Count: 1, Period: time.Hour})
+	if err := l.Wait(ctx, LimitMarket, "/public"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error %v, want deadline exceeded", err)
+	}
+}
+
+func TestLimiterUpdate(t *testing.T) {
+	l := NewLimiter().WithMode(LimitFailFast).WithLimit(LimitOrder, Limit{Count: 100, Period: 5 * time.Second})
+	path := "/private/order/create"
+	header := func(limit, status int, reset time.Time) http.Header {
+		h := http.Header{}
+		h.Set("X-Bapi-Limit", strconv.Itoa(limit))
+		h.Set("X-Bapi-Limit-Status", strconv.Itoa(status))
+		h.Set("X-Bapi-Limit-Reset-Timestamp", strconv.FormatInt(reset.UnixMilli(), 10))
+		return h
+	}
+
+	l.Update(LimitOrder, path, header(10, 9, time.Now()))
+	b := l.bucket(LimitOrder, path)
+	if b.capacity != 10 || b.rate != 2 || b.tokens != 9 {
+		t.Errorf("capacity %v rate %v tokens %v, want 10 2 9", b.capacity, b.rate, b.tokens)
+	}
+
+	// A higher remaining count than tracked is ignored
+	l.Update(LimitOrder, path, header(10, 10, time.Now()))
+	if b.tokens != 9 {
+		t.Errorf("tokens %v, want 9", b.tokens)
+	}
+
+	reset := time.Now().Add(time.Hour)
+	l.Update(LimitOrder, path, header(10, 0, reset))
+	if !b.resetAt.Equal(reset.Truncate(time.Millisecond)) {
+		t.Errorf("reset at %s, want %s", b.resetAt, reset)
+	}
+	if err := l.Wait(context.Background(), LimitOrder, path); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("exhausted quota: %v", err)
+	}
+	if d := b.take(time.Now()); d < 59*time.Minute {
+		t.Errorf("delay %s, want until reset", d)
+	}
+
+	// Headers without status are ignored
+	l.Update(LimitOrder, path, http.Header{"X-Bapi-Limit": {"1"}})
+	if b.capacity != 10 {
+		t.Errorf("capacity %v, want 10", b.capacity)
+	}
+}
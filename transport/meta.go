@@ -0,0 +1,105 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Response metadata: http status and headers, rate limit quota, server time, round-trip latency and raw body
+type Meta struct {
+	Status      int
+	Header      http.Header
+	Latency     time.Duration
+	Body        []byte
+	Limit       int // Request limit of the endpoint, -1 if unknown
+	LimitStatus int // Remaining requests, -1 if unknown
+	LimitReset  time.Time
+	ServerTime  time.Time
+}
+
+func (o *Meta) HasLimit() bool {
+	return o.LimitStatus >= 0
+}
+
+// Response envelope receiving metadata
+type MetaReceiver interface {
+	SetMeta(Meta)
+}
+
+type metaKey struct{}
+
+// Context which makes every request executed with it store the response metadata into meta
+func ContextWithMeta(ctx context.Context, meta *Meta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+func metaFromContext(ctx context.Context) *Meta {
+	m, _ := ctx.Value(metaKey{}).(*Meta)
+	return m
+}
+
+func newMeta(resp *http.Response, latency time.Duration, body []byte) Meta {
+	m := Meta{
+		Status:      resp.StatusCode,
+		Header:      resp.Header,
+		Latency:     latency,
+		Body:        body,
+		Limit:       headerInt(resp.Header, "X-Bapi-Limit"),
+		LimitStatus: headerInt(resp.Header, "X-Bapi-Limit-Status"),
+	}
+	if reset := headerInt(resp.Header, "X-Bapi-Limit-Reset-Timestamp"); reset > 0 {
+		m.LimitReset = time.UnixMilli(int64(reset))
+	}
+	if now := headerInt(resp.Header, "Timenow"); now > 0 {
+		m.ServerTime = time.UnixMilli(int64(now))
+	}
+	return m
+}
+
+// Complete metadata by response envelope fields (time_now, rate_limit_status, ...)
+func (o *Meta) fromEnvelope(v reflect.Value) {
+	if o.LimitStatus < 0 {
+		// Limit fields are sent together and rate_limit is positive, so a zero status
+		// with a limit is an exhausted quota, while no limit means no limit fields
+		limit := v.FieldByName("RateLimit")
+		if f := v.FieldByName("RateLimitStatus"); f.IsValid() && f.CanInt() && limit.IsValid() && limit.CanInt() && limit.Int() > 0 {
+			o.LimitStatus = int(f.Int())
+			o.Limit = int(limit.Int())
+			if f := v.FieldByName("RateLimitResetMs"); f.IsValid() && f.CanInt() && f.Int() > 0 {
+				o.LimitReset = time.UnixMilli(f.Int())
+			}
+		}
+	}
+	if o.ServerTime.IsZero() {
+		if f := v.FieldByName("TimeNow"); f.IsValid() {
+			switch {
+			case f.Kind() == reflect.String:
+				if sec, err := strconv.ParseFloat(f.String(), 64); err == nil {
+					o.ServerTime = time.UnixMicro(int64(sec * 1e6))
+				}
+			case f.CanInt():
+				o.ServerTime = time.UnixMilli(f.Int())
+			}
+		} else if f := v.FieldByName("Time"); f.IsValid() {
+			if t, ok := f.Interface().(Timestamp); ok {
+				o.ServerTime = t.Time()
+			}
+		}
+	}
+}
+
+func headerInt(h http.Header, name string) int {
+	s := strings.TrimSpace(h.Get(name))
+	if s == "" {
+		return -1
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return -1
+	}
+	return i
+}
@@ -0,0 +1,56 @@
+package transport
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMetaFromEnvelope(t *testing.T) {
+	type envelope struct {
+		RateLimitStatus  int
+		RateLimitResetMs int64
+		RateLimit        int
+	}
+	tests := []struct {
+		name   string
+		v      any
+		status int
+		limit  int
+	}{
+		{"remaining", envelope{RateLimitStatus: 99, RateLimit: 100}, 99, 100},
+		{"exhausted", envelope{RateLimitStatus: 0, RateLimit: 100}, 0, 100},
+		{"no limit fields", envelope{}, -1, -1},
+		{"no envelope fields", struct{ Result int }{}, -1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Meta{Limit: -1, LimitStatus: -1}
+			m.fromEnvelope(reflect.ValueOf(tt.v))
+			if m.LimitStatus != tt.status || m.Limit != tt.limit {
+				t.Errorf("status %d limit %d, want %d %d", m.LimitStatus, m.Limit, tt.status, tt.limit)
+			}
+		})
+	}
+}
+
+func TestMetaServerTime(t *testing.T) {
+	at := time.UnixMilli(1664627415123)
+	tests := []struct {
+		name string
+		v    any
+	}{
+		{"seconds string", struct{ TimeNow string }{"1664627415.123000"}},
+		{"milliseconds", struct{ TimeNow int64 }{1664627415123}},
+		{"timestamp", struct{ Time Timestamp }{Timestamp(at)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Meta
+			m.fromEnvelope(reflect.ValueOf(tt.v))
+			if !m.ServerTime.Equal(at) {
+				t.Errorf("server time %s, want %s", m.ServerTime, at)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package transport
+
+import (
+	"context"
+	"time"
+)
+
+// Fetches the next page: items and whether another page may follow
+type PageFetch[T any] func(ctx context.Context) ([]T, bool, error)
+
+// Iterator over all items of a paginated endpoint. Pages are requested on demand,
+// iteration stops at the last page, on error or once the context is done:
+//
+//	it := req.Iter(client)
+//	for it.Next() {
+//		v := it.Item()
+//	}
+//	err := it.Err()
+type Pager[T any] struct {
+	ctx    context.Context
+	fetch  PageFetch[T]
+	filter func(T) bool
+	stop   func(T) bool
+	page   []T
+	more   bool
+	item   T
+	err    error
+}
+
+func NewPager[T any](ctx context.Context, fetch PageFetch[T]) *Pager[T] {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &Pager[T]{ctx: ctx, fetch: fetch, more: true}
+}
+
+// Skip items for which keep returns false
+func (o *Pager[T]) WithFilter(keep func(T) bool) *Pager[T] {
+	o.filter = keep
+	return o
+}
+
+// End the iteration at the first item for which stop returns true, without fetching further pages
+func (o *Pager[T]) WithStop(stop func(T) bool) *Pager[T] {
+	o.stop = stop
+	return o
+}
+
+// Keep items with time in [start, end); zero bound is open.
+// For endpoints without server-side time filter. Items are only filtered,
+// so all pages are still fetched; see WithNewestWindow
+func (o *Pager[T]) WithWindow(start time.Time, end time.Time, at func(T) time.Time) *Pager[T] {
+	return o.WithFilter(func(v T) bool {
+		t := at(v)
+		return (start.IsZero() || !t.Before(start)) && (end.IsZero() || t.Before(end))
+	})
+}
+
+// WithWindow for endpoints listing items newest first: the first item
+// before start ends the iteration, older pages are not fetched
+func (o *Pager[T]) WithNewestWindow(start time.Time, end time.Time, at func(T) time.Time) *Pager[T] {
+	if !start.IsZero() {
+		o.WithStop(func(v T) bool {
+			return at(v).Before(start)
+		})
+	}
+	return o.WithWindow(start, end, at)
+}
+
+func (o *Pager[T]) Next() bool {
+	for {
+		if o.err != nil {
+			return false
+		}
+		if o.err = o.ctx.Err(); o.err != nil {
+			return false
+		}
+		if len(o.page) == 0 {
+			if !o.more {
+				return false
+			}
+			o.page, o.more, o.err = o.fetch(o.ctx)
+			continue
+		}
+		o.item = o.page[0]
+		o.page = o.page[1:]
+		if o.stop != nil && o.stop(o.item) {
+			o.page, o.more = nil, false
+			return false
+		}
+		if o.filter == nil || o.filter(o.item) {
+			return true
+		}
+	}
+}
+
+func (o *Pager[T]) Item() T {
+	return o.item
+}
+
+func (o *Pager[T]) Err() error {
+	return o.err
+}
+
+// Calls f for each item until it returns false
+func (o *Pager[T]) Each(f func(T) bool) error {
+	for o.Next() {
+		if !f(o.Item()) {
+			break
+		}
+	}
+	return o.Err()
+}
+
+// Remaining items
+func (o *Pager[T]) All() ([]T, error) {
+	var l []T
+	for o.Next() {
+		l = append(l, o.Item())
+	}
+	return l, o.Err()
+}
+
+// Page-number paging starting from the page (1 if zero). A page shorter than limit
+// (or empty when limit is zero) is the last
+func PageNumber[T any](page int, limit int, fetch func(ctx context.Context, page int) ([]T, error)) PageFetch[T] {
+	if page < 1 {
+		page = 1
+	}
+	return func(ctx context.Context) ([]T, bool, error) {
+		l, err := fetch(ctx, page)
+		page++
+		return l, len(l) > 0 && (limit == 0 || len(l) >= limit), err
+	}
+}
+
+// Cursor paging: each page returns the cursor of the next; empty or repeated cursor ends it
+func Cursor[T any](cursor string, fetch func(ctx context.Context, cursor string) ([]T, string, error)) PageFetch[T] {
+	return func(ctx context.Context) ([]T, bool, error) {
+		l, next, err := fetch(ctx, cursor)
+		more := len(l) > 0 && next != "" && next != cursor
+		cursor = next
+		return l, more, err
+	}
+}
+
+// Id paging: the next page starts after the id of the last item. A page shorter than limit
+// (or empty when limit is zero) is the last
+func ByID[T any](id string, limit int, key func(T) string, fetch func(ctx context.Context, id string) ([]T, error)) PageFetch[T] {
+	return func(ctx context.Context) ([]T, bool, error) {
+		l, err := fetch(ctx, id)
+		if err != nil || len(l) == 0 {
+			return l, false, err
+		}
+		next := key(l[len(l)-1])
+		more := next != id && (limit == 0 || len(l) >= limit)
+		id = next
+		return l, more, nil
+	}
+}
+
+// Value of an optional request param or zero
+func Deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+// Copy of an optional request param, changed without touching the caller's value
+func Clone[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
@@ -0,0 +1,197 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPageNumber(t *testing.T) {
+	tests := []struct {
+		name  string
+		start int
+		limit int
+		pages [][]int
+		want  []int // requested pages
+	}{
+		{"short page", 0, 2, [][]int{{1, 2}, {3, 4}, {5}}, []int{1, 2, 3}},
+		{"full last page", 1, 2, [][]int{{1, 2}, {3, 4}, {}}, []int{1, 2, 3}},
+		{"no limit", 2, 0, [][]int{{1, 2}, {3}, {}}, []int{2, 3, 4}},
+		{"empty", 1, 2, [][]int{{}}, []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []int
+			fetch := PageNumber(tt.start, tt.limit, func(ctx context.Context, page int) ([]int, error) {
+				requested = append(requested, page)
+				return tt.pages[len(requested)-1], nil
+			})
+			l, err := NewPager(nil, fetch).All()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(requested, tt.want) {
+				t.Errorf("requested pages %v, want %v", requested, tt.want)
+			}
+			if n := countItems(tt.pages); len(l) != n {
+				t.Errorf("%d items, want %d", len(l), n)
+			}
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	type page struct {
+		items []int
+		next  string
+	}
+	tests := []struct {
+		name  string
+		pages []page
+		want  []string // requested cursors
+	}{
+		{"empty cursor", []page{{[]int{1}, "a"}, {[]int{2}, "b"}, {[]int{3}, ""}}, []string{"", "a", "b"}},
+		{"repeated cursor", []page{{[]int{1}, "a"}, {[]int{2}, "a"}}, []string{"", "a"}},
+		{"empty page", []page{{[]int{1}, "a"}, {nil, "b"}}, []string{"", "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []string
+			fetch := Cursor("", func(ctx context.Context, cursor string) ([]int, string, error) {
+				requested = append(requested, cursor)
+				p := tt.pages[len(requested)-1]
+				return p.items, p.next, nil
+			})
+			if _, err := NewPager(nil, fetch).All(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(requested, tt.want) {
+				t.Errorf("requested cursors %q, want %q", requested, tt.want)
+			}
+		})
+	}
+}
+
+func TestByID(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		pages [][]int
+		want  []string // requested ids
+	}{
+		{"short page", 2, [][]int{{1, 2}, {3}}, []string{"", "2"}},
+		{"full last page", 2, [][]int{{1, 2}, {3, 4}, {}}, []string{"", "2", "4"}},
+		{"no limit", 0, [][]int{{1, 2}, {3}, {}}, []string{"", "2", "3"}},
+		{"repeated id", 1, [][]int{{1}, {1}}, []string{"", "1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []string
+			fetch := ByID("", tt.limit, strconv.Itoa, func(ctx context.Context, id string) ([]int, error) {
+				requested = append(requested, id)
+				return tt.pages[len(requested)-1], nil
+			})
+			if _, err := NewPager(nil, fetch).All(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(requested, tt.want) {
+				t.Errorf("requested ids %q, want %q", requested, tt.want)
+			}
+		})
+	}
+}
+
+func TestPagerWindow(t *testing.T) {
+	base := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	at := func(v int) time.Time {
+		return base.Add(time.Duration(v) * time.Minute)
+	}
+	// Newest first, 3 per page
+	pages := [][]int{{9, 8, 7}, {6, 5, 4}, {3, 2, 1}}
+	newPager := func(fetched *int) *Pager[int] {
+		return NewPager(nil, PageNumber(1, 3, func(ctx context.Context, page int) ([]int, error) {
+			*fetched = page
+			if page > len(pages) {
+				return nil, nil
+			}
+			return pages[page-1], nil
+		}))
+	}
+	tests := []struct {
+		name    string
+		newest  bool
+		start   time.Time
+		end     time.Time
+		want    []int
+		fetched int
+	}{
+		{"filter", false, at(5), at(8), []int{7, 6, 5}, 4},
+		{"newest first", true, at(5), at(8), []int{7, 6, 5}, 2},
+		{"newest first open start", true, time.Time{}, at(3), []int{2, 1}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fetched int
+			p := newPager(&fetched)
+			if tt.newest {
+				p.WithNewestWindow(tt.start, tt.end, at)
+			} else {
+				p.WithWindow(tt.start, tt.end, at)
+			}
+			l, err := p.All()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(l, tt.want) {
+				t.Errorf("items %v, want %v", l, tt.want)
+			}
+			if fetched != tt.fetched {
+				t.Errorf("fetched %d pages, want %d", fetched, tt.fetched)
+			}
+		})
+	}
+}
+
+func TestPagerStop(t *testing.T) {
+	fail := errors.New("fail")
+	calls := 0
+	p := NewPager(nil, func(ctx context.Context) ([]int, bool, error) {
+		calls++
+		if calls == 2 {
+			return nil, true, fail
+		}
+		return []int{calls}, true, nil
+	})
+	l, err := p.All()
+	if !errors.Is(err, fail) || len(l) != 1 || calls != 2 {
+		t.Errorf("items %v error %v after %d calls", l, err, calls)
+	}
+	if p.Next() || calls != 2 {
+		t.Error("next after error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p = NewPager(ctx, func(ctx context.Context) ([]int, bool, error) {
+		return []int{1, 2}, true, nil
+	})
+	n := 0
+	err = p.Each(func(v int) bool {
+		if n++; n == 3 {
+			cancel()
+		}
+		return n < 10
+	})
+	if !errors.Is(err, context.Canceled) || n != 3 {
+		t.Errorf("error %v after %d items", err, n)
+	}
+}
+
+func countItems(pages [][]int) (n int) {
+	for _, p := range pages {
+		n += len(p)
+	}
+	return
+}
@@ -38,7 +38,7 @@ func (o Param) From(v any) Param {
 	if v == nil {
 		return o
 	}
-	rv := reflect.ValueOf(v)
+	rv := reflect.Indirect(reflect.ValueOf(v))
 	if rv.Type().Kind() != reflect.Struct {
 		panic("url param from: object is not struct")
 	}
@@ -0,0 +1,217 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// Writes bars as Apache Parquet file: one row group, plain encoding, no compression.
+// Column time is INT64 timestamp (milliseconds, UTC), price and volume columns are DOUBLE
+func WriteParquet(w io.Writer, bars []Bar) error {
+	type column struct {
+		name   string
+		kind   int32
+		values func(Bar) uint64
+	}
+	double := func(f func(Bar) Decimal) func(Bar) uint64 {
+		return func(v Bar) uint64 {
+			return math.Float64bits(f(v).Float64())
+		}
+	}
+	columns := []column{
+		{"time", parquetInt64, func(v Bar) uint64 { return uint64(v.Time.UnixMilli()) }},
+		{"open", parquetDouble, double(func(v Bar) Decimal { return v.Open })},
+		{"high", parquetDouble, double(func(v Bar) Decimal { return v.High })},
+		{"low", parquetDouble, double(func(v Bar) Decimal { return v.Low })},
+		{"close", parquetDouble, double(func(v Bar) Decimal { return v.Close })},
+		{"volume", parquetDouble, double(func(v Bar) Decimal { return v.Volume })},
+		{"turnover", parquetDouble, double(func(v Bar) Decimal { return v.Turnover })},
+	}
+	var file bytes.Buffer
+	file.WriteString("PAR1")
+	offsets := make([]int64, len(columns))
+	sizes := make([]int64, len(columns))
+	for i, c := range columns {
+		data := make([]byte, 8*len(bars))
+		for n, v := range bars {
+			binary.LittleEndian.PutUint64(data[8*n:], c.values(v))
+		}
+		var h thriftWriter
+		h.begin()
+		h.i32(1, 0) // DATA_PAGE
+		h.i32(2, int32(len(data)))
+		h.i32(3, int32(len(data)))
+		h.structField(5)
+		h.i32(1, int32(len(bars)))
+		h.i32(2, 0) // PLAIN
+		h.i32(3, 3) // RLE
+		h.i32(4, 3)
+		h.end()
+		h.end()
+		offsets[i] = int64(file.Len())
+		sizes[i] = int64(h.b.Len() + len(data))
+		file.Write(h.b.Bytes())
+		file.Write(data)
+	}
+	var m thriftWriter
+	m.begin()
+	m.i32(1, 1)
+	m.list(2, thriftStruct, len(columns)+1)
+	m.begin()
+	m.str(4, "schema")
+	m.i32(5, int32(len(columns)))
+	m.end()
+	for _, c := range columns {
+		m.begin()
+		m.i32(1, c.kind)
+		m.i32(3, 0) // REQUIRED
+		m.str(4, c.name)
+		if c.name == "time" {
+			m.i32(6, 9) // TIMESTAMP_MILLIS
+			m.structField(10)
+			m.structField(8) // TIMESTAMP
+			m.bool(1, true)
+			m.structField(2)
+			m.structField(1) // MILLIS
+			m.end()
+			m.end()
+			m.end()
+			m.end()
+		}
+		m.end()
+	}
+	m.i64(3, int64(len(bars)))
+	m.list(4, thriftStruct, 1)
+	m.begin()
+	m.list(1, thriftStruct, len(columns))
+	var total int64
+	for i, c := range columns {
+		m.begin()
+		m.i64(2, offsets[i])
+		m.structField(3)
+		m.i32(1, c.kind)
+		m.list(2, thriftI32, 1)
+		m.varint(0)
+		m.list(3, thriftBinary, 1)
+		m.bytes(c.name)
+		m.i32(4, 0) // UNCOMPRESSED
+		m.i64(5, int64(len(bars)))
+		m.i64(6, sizes[i])
+		m.i64(7, sizes[i])
+		m.i64(9, offsets[i])
+		m.end()
+		m.end()
+		total += sizes[i]
+	}
+	m.i64(2, total)
+	m.i64(3, int64(len(bars)))
+	m.end()
+	m.str(6, "gobybit")
+	m.end()
+	file.Write(m.b.Bytes())
+	binary.Write(&file, binary.LittleEndian, uint32(m.b.Len()))
+	file.WriteString("PAR1")
+	_, err := w.Write(file.Bytes())
+	return err
+}
+
+const (
+	parquetInt64  = 2
+	parquetDouble = 5
+)
+
+const (
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// Thrift compact protocol encoder for parquet metadata
+type thriftWriter struct {
+	b    bytes.Buffer
+	last []int16
+}
+
+func (o *thriftWriter) begin() {
+	o.last = append(o.last, 0)
+}
+
+func (o *thriftWriter) end() {
+	o.b.WriteByte(0)
+	o.last = o.last[:len(o.last)-1]
+}
+
+func (o *thriftWriter) field(id int16, kind byte) {
+	top := len(o.last) - 1
+	if delta := id - o.last[top]; delta > 0 && delta <= 15 {
+		o.b.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		o.b.WriteByte(kind)
+		o.varint(int64(id))
+	}
+	o.last[top] = id
+}
+
+// Zigzag varint of integer fields and list elements
+func (o *thriftWriter) varint(v int64) {
+	o.uvarint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+func (o *thriftWriter) uvarint(u uint64) {
+	for u >= 0x80 {
+		o.b.WriteByte(byte(u) | 0x80)
+		u >>= 7
+	}
+	o.b.WriteByte(byte(u))
+}
+
+func (o *thriftWriter) bytes(s string) {
+	o.uvarint(uint64(len(s)))
+	o.b.WriteString(s)
+}
+
+func (o *thriftWriter) i32(id int16, v int32) {
+	o.field(id, thriftI32)
+	o.varint(int64(v))
+}
+
+func (o *thriftWriter) i64(id int16, v int64) {
+	o.field(id, thriftI64)
+	o.varint(v)
+}
+
+func (o *thriftWriter) bool(id int16, v bool) {
+	if v {
+		o.field(id, thriftTrue)
+	} else {
+		o.field(id, thriftFalse)
+	}
+}
+
+func (o *thriftWriter) str(id int16, s string) {
+	o.field(id, thriftBinary)
+	o.bytes(s)
+}
+
+// Struct field; its fields follow until end
+func (o *thriftWriter) structField(id int16) {
+	o.field(id, thriftStruct)
+	o.begin()
+}
+
+// List header; elements follow (structs with begin/end)
+func (o *thriftWriter) list(id int16, kind byte, n int) {
+	o.field(id, thriftList)
+	if n < 15 {
+		o.b.WriteByte(byte(n)<<4 | kind)
+		return
+	}
+	o.b.WriteByte(0xf0 | kind)
+	o.uvarint(uint64(n))
+}
@@ -0,0 +1,263 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestWriteParquet(t *testing.T) {
+	for _, bars := range [][]Bar{testBars(0, 1, 2), nil} {
+		t.Run(fmt.Sprintf("%d bars", len(bars)), func(t *testing.T) {
+			testParquet(t, bars)
+		})
+	}
+}
+
+func testParquet(t *testing.T, bars []Bar) {
+	bars = append(bars[:0:0], bars...)
+	for i := range bars {
+		bars[i].High = bars[i].High.Add(DecimalFromInt(int64(i)))
+	}
+	var buf bytes.Buffer
+	if err := WriteParquet(&buf, bars); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	if len(b) < 12 || string(b[:4]) != "PAR1" || string(b[len(b)-4:]) != "PAR1" {
+		t.Fatalf("no parquet magic")
+	}
+	size := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	footer := len(b) - 8 - size
+	if footer < 4 {
+		t.Fatalf("footer length %d", size)
+	}
+	meta, n, err := readThrift(b[footer : len(b)-8])
+	if err != nil {
+		t.Fatal("footer:", err)
+	}
+	if n != size {
+		t.Errorf("footer of %d bytes, decoded %d", size, n)
+	}
+	if v := meta.int(1); v != 1 {
+		t.Errorf("version %d", v)
+	}
+	if v := meta.str(6); v != "gobybit" {
+		t.Errorf("created by %q", v)
+	}
+	if v := meta.int(3); v != int64(len(bars)) {
+		t.Errorf("%d rows, want %d", v, len(bars))
+	}
+
+	type column struct {
+		name  string
+		kind  int64
+		value func(Bar) uint64
+	}
+	double := func(f func(Bar) Decimal) func(Bar) uint64 {
+		return func(v Bar) uint64 { return math.Float64bits(f(v).Float64()) }
+	}
+	columns := []column{
+		{"time", parquetInt64, func(v Bar) uint64 { return uint64(v.Time.UnixMilli()) }},
+		{"open", parquetDouble, double(func(v Bar) Decimal { return v.Open })},
+		{"high", parquetDouble, double(func(v Bar) Decimal { return v.High })},
+		{"low", parquetDouble, double(func(v Bar) Decimal { return v.Low })},
+		{"close", parquetDouble, double(func(v Bar) Decimal { return v.Close })},
+		{"volume", parquetDouble, double(func(v Bar) Decimal { return v.Volume })},
+		{"turnover", parquetDouble, double(func(v Bar) Decimal { return v.Turnover })},
+	}
+
+	schema := meta.list(2)
+	if len(schema) != len(columns)+1 {
+		t.Fatalf("%d schema elements", len(schema))
+	}
+	root := schema[0].(thriftFields)
+	if root.str(4) != "schema" || root.int(5) != int64(len(columns)) {
+		t.Errorf("schema root %v", root)
+	}
+	for i, c := range columns {
+		e := schema[i+1].(thriftFields)
+		if e.str(4) != c.name || e.int(1) != c.kind || e.int(3) != 0 {
+			t.Errorf("schema element %d: %v", i, e)
+		}
+	}
+	stamp := schema[1].(thriftFields)
+	if stamp.int(6) != 9 {
+		t.Errorf("time converted type %d, want TIMESTAMP_MILLIS", stamp.int(6))
+	}
+	if ts := stamp.fields(10).fields(8); ts[1] != true || ts.fields(2)[1] == nil {
+		t.Errorf("time logical type %v, want UTC TIMESTAMP(MILLIS)", stamp.fields(10))
+	}
+
+	groups := meta.list(4)
+	if len(groups) != 1 {
+		t.Fatalf("%d row groups", len(groups))
+	}
+	group := groups[0].(thriftFields)
+	if group.int(3) != int64(len(bars)) {
+		t.Errorf("row group of %d rows", group.int(3))
+	}
+	chunks := group.list(1)
+	if len(chunks) != len(columns) {
+		t.Fatalf("%d column chunks", len(chunks))
+	}
+	offset := int64(4)
+	for i, c := range columns {
+		chunk := chunks[i].(thriftFields)
+		cm := chunk.fields(3)
+		if chunk.int(2) != offset || cm.int(9) != offset {
+			t.Errorf("%s: offset %d, page at %d, want %d", c.name, chunk.int(2), cm.int(9), offset)
+		}
+		if cm.int(1) != c.kind || !reflect.DeepEqual(cm.list(3), []any{c.name}) || cm.int(4) != 0 {
+			t.Errorf("%s: column meta %v", c.name, cm)
+		}
+		if cm.int(5) != int64(len(bars)) || cm.int(6) != cm.int(7) {
+			t.Errorf("%s: %d values, sizes %d %d", c.name, cm.int(5), cm.int(6), cm.int(7))
+		}
+
+		page, n, err := readThrift(b[offset:footer])
+		if err != nil {
+			t.Fatalf("%s: page header: %v", c.name, err)
+		}
+		data := 8 * len(bars)
+		if page.int(1) != 0 || page.int(2) != int64(data) || page.int(3) != int64(data) {
+			t.Errorf("%s: page header %v", c.name, page)
+		}
+		if h := page.fields(5); h.int(1) != int64(len(bars)) || h.int(2) != 0 || h.int(3) != 3 || h.int(4) != 3 {
+			t.Errorf("%s: data page header %v", c.name, h)
+		}
+		if int64(n+data) != cm.int(6) {
+			t.Errorf("%s: chunk of %d bytes, header and data %d", c.name, cm.int(6), n+data)
+		}
+		values := b[offset+int64(n):]
+		for r, v := range bars {
+			if got := binary.LittleEndian.Uint64(values[8*r:]); got != c.value(v) {
+				t.Errorf("%s row %d: %x, want %x", c.name, r, got, c.value(v))
+			}
+		}
+		offset += cm.int(6)
+	}
+	if offset != int64(footer) {
+		t.Errorf("column chunks end at %d, footer at %d", offset, footer)
+	}
+	if group.int(2) != offset-4 {
+		t.Errorf("row group of %d bytes, want %d", group.int(2), offset-4)
+	}
+}
+
+// Struct of thrift compact protocol: integers as int64, binary as string,
+// lists as []any, bool fields as bool
+type thriftFields map[int16]any
+
+func (o thriftFields) int(id int16) int64 {
+	v, _ := o[id].(int64)
+	return v
+}
+
+func (o thriftFields) str(id int16) string {
+	v, _ := o[id].(string)
+	return v
+}
+
+func (o thriftFields) list(id int16) []any {
+	v, _ := o[id].([]any)
+	return v
+}
+
+func (o thriftFields) fields(id int16) thriftFields {
+	v, _ := o[id].(thriftFields)
+	return v
+}
+
+// Decodes a struct from the start of b; returns its length
+func readThrift(b []byte) (v thriftFields, n int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("thrift: %v", r)
+		}
+	}()
+	o := thriftReader{b: b}
+	v = o.structure()
+	return v, o.n, nil
+}
+
+type thriftReader struct {
+	b []byte
+	n int
+}
+
+func (o *thriftReader) byte() byte {
+	if o.n >= len(o.b) {
+		panic("unexpected end")
+	}
+	o.n++
+	return o.b[o.n-1]
+}
+
+func (o *thriftReader) uvarint() uint64 {
+	var u uint64
+	for shift := 0; ; shift += 7 {
+		c := o.byte()
+		u |= uint64(c&0x7f) << shift
+		if c < 0x80 {
+			return u
+		}
+	}
+}
+
+func (o *thriftReader) varint() int64 {
+	u := o.uvarint()
+	return int64(u>>1) ^ -int64(u&1)
+}
+
+func (o *thriftReader) structure() thriftFields {
+	m := make(thriftFields)
+	var id int16
+	for {
+		h := o.byte()
+		if h == 0 {
+			return m
+		}
+		if delta := int16(h >> 4); delta > 0 {
+			id += delta
+		} else {
+			id = int16(o.varint())
+		}
+		m[id] = o.value(h & 0x0f)
+	}
+}
+
+func (o *thriftReader) value(kind byte) any {
+	switch kind {
+	case thriftTrue:
+		return true
+	case thriftFalse:
+		return false
+	case thriftI32, thriftI64:
+		return o.varint()
+	case thriftBinary:
+		size := int(o.uvarint())
+		if o.n+size > len(o.b) {
+			panic("unexpected end")
+		}
+		o.n += size
+		return string(o.b[o.n-size : o.n])
+	case thriftList:
+		h := o.byte()
+		size := int(h >> 4)
+		if size == 15 {
+			size = int(o.uvarint())
+		}
+		l := make([]any, size)
+		for i := range l {
+			l[i] = o.value(h & 0x0f)
+		}
+		return l
+	case thriftStruct:
+		return o.structure()
+	}
+	panic(fmt.Sprintf("type %d", kind))
+}
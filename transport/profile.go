@@ -0,0 +1,68 @@
+package transport
+
+import (
+	"strings"
+	"sync"
+)
+
+// Set of matching REST and websocket endpoints
+type Profile struct {
+	Name            string
+	Rest            string
+	WsInverse       string // Inverse perpetual (public and private)
+	WsLinearPublic  string // USDT perpetual public
+	WsLinearPrivate string // USDT perpetual private
+	WsSpotPublic    string // Spot v3 public
+	WsSpotPrivate   string // Spot v3 private
+	WsSpotPublicV1  string // Spot v1 public
+	WsSpotPrivateV1 string // Spot v1 private
+}
+
+// Profile with standard Bybit paths on the given hosts, e.g. NewProfile("local", "http://127.0.0.1:8080", "ws://127.0.0.1:8080")
+func NewProfile(name string, restUrl string, wsUrl string) Profile {
+	ws := strings.TrimSuffix(wsUrl, "/")
+	return Profile{
+		Name:            name,
+		Rest:            restUrl,
+		WsInverse:       ws + "/realtime",
+		WsLinearPublic:  ws + "/realtime_public",
+		WsLinearPrivate: ws + "/realtime_private",
+		WsSpotPublic:    ws + "/spot/public/v3",
+		WsSpotPrivate:   ws + "/spot/private/v3",
+		WsSpotPublicV1:  ws + "/spot/quote/ws/v1",
+		WsSpotPrivateV1: ws + "/spot/ws",
+	}
+}
+
+var (
+	Mainnet = NewProfile("mainnet", MainBaseUrl, "wss://stream.bybit.com")
+	ByTick  = NewProfile("bytick", MainBaseByTickUrl, "wss://stream.bytick.com")
+	Testnet = NewProfile("testnet", TestBaseUrl, "wss://stream-testnet.bybit.com")
+)
+
+func ProfileByName(name string) (Profile, bool) {
+	for _, p := range []Profile{Mainnet, ByTick, Testnet} {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Profile{}, false
+}
+
+var (
+	defaultProfile      = Mainnet
+	defaultProfileMutex sync.RWMutex
+)
+
+// Profile used by clients created afterwards (REST and all websocket clients)
+func SetDefaultProfile(profile Profile) {
+	defaultProfileMutex.Lock()
+	defer defaultProfileMutex.Unlock()
+	defaultProfile = profile
+}
+
+func DefaultProfile() Profile {
+	defaultProfileMutex.RLock()
+	defer defaultProfileMutex.RUnlock()
+	return defaultProfile
+}
@@ -0,0 +1,269 @@
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type RecordKind string
+
+const (
+	RecordRest   RecordKind = "rest"    // REST request and response
+	RecordWsSent RecordKind = "ws-sent" // Websocket frame sent
+	RecordWsRecv RecordKind = "ws-recv" // Websocket frame received
+)
+
+// Line of a recording. Credentials (api_key, sign, websocket auth args) are stripped
+type Record struct {
+	Time    time.Time  `json:"time"`
+	Kind    RecordKind `json:"kind"`
+	Url     string     `json:"url,omitempty"` // Websocket endpoint
+	Method  string     `json:"method,omitempty"`
+	Path    string     `json:"path,omitempty"`
+	Query   string     `json:"query,omitempty"`
+	Request string     `json:"request,omitempty"` // Request body
+	Status  int        `json:"status,omitempty"`
+	Latency int64      `json:"latency_ms,omitempty"`
+	Body    string     `json:"body,omitempty"` // Response body or websocket frame
+	Error   string     `json:"error,omitempty"`
+}
+
+// Credentials never written to a recording
+var recordStripped = []string{"api_key", "sign"}
+
+// Writes REST exchanges and websocket frames as JSON lines
+type Recorder struct {
+	mutex  sync.Mutex
+	enc    *json.Encoder
+	closer io.Closer
+}
+
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w)}
+}
+
+// Recorder appending to the file
+func NewFileRecorder(path string) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	o := NewRecorder(f)
+	o.closer = f
+	return o, nil
+}
+
+func (o *Recorder) Close() error {
+	if o.closer == nil {
+		return nil
+	}
+	return o.closer.Close()
+}
+
+func (o *Recorder) Write(r Record) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.enc.Encode(r)
+}
+
+// Interceptor writing each REST exchange
+func (o *Recorder) Interceptor() Interceptor {
+	return func(x *Exchange, next Handler) error {
+		err := next(x)
+		r := Record{
+			Time:    x.Time,
+			Kind:    RecordRest,
+			Method:  x.Method,
+			Path:    x.Request.URL.Path,
+			Query:   stripQuery(x.Request.URL.RawQuery),
+			Request: string(stripJson(x.RequestBody)),
+			Latency: x.Latency.Milliseconds(),
+			Body:    string(x.ResponseBody),
+		}
+		if x.Response != nil {
+			r.Status = x.Response.StatusCode
+		}
+		if err != nil {
+			r.Error = err.Error()
+		}
+		o.Write(r)
+		return err
+	}
+}
+
+func (o *Recorder) ws(kind RecordKind, url string, frame []byte) {
+	if kind == RecordWsSent {
+		frame = stripJson(frame)
+	}
+	o.Write(Record{
+		Time: time.Now(),
+		Kind: kind,
+		Url:  url,
+		Body: string(frame),
+	})
+}
+
+func stripQuery(query string) string {
+	v, err := url.ParseQuery(query)
+	if err != nil {
+		return query
+	}
+	for _, k := range recordStripped {
+		v.Del(k)
+	}
+	return v.Encode()
+}
+
+// Remove credentials from a JSON object: request params and websocket auth args
+func stripJson(b []byte) []byte {
+	if !bytes.HasPrefix(b, []byte("{")) {
+		return b
+	}
+	var m map[string]any
+	if json.Unmarshal(b, &m) != nil {
+		return b
+	}
+	for _, k := range recordStripped {
+		delete(m, k)
+	}
+	if m["op"] == "auth" {
+		delete(m, "args")
+	}
+	r, err := json.Marshal(m)
+	if err != nil {
+		return b
+	}
+	return r
+}
+
+func ReadRecords(r io.Reader) ([]Record, error) {
+	var l []Record
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+	for s.Scan() {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		var v Record
+		if err := json.Unmarshal(s.Bytes(), &v); err != nil {
+			return nil, fmt.Errorf("record %d: %v", len(l)+1, err)
+		}
+		l = append(l, v)
+	}
+	return l, s.Err()
+}
+
+// Recorded session played back: REST responses through RoundTrip (use with WithRoundTripper),
+// websocket frames through WsConf.Replay instead of a network connection
+type Replay struct {
+	mutex   sync.Mutex
+	records []Record
+	used    []bool
+}
+
+func NewReplay(records []Record) *Replay {
+	return &Replay{
+		records: records,
+		used:    make([]bool, len(records)),
+	}
+}
+
+func LoadReplay(path string) (*Replay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	l, err := ReadRecords(f)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplay(l), nil
+}
+
+// Response of the first unused recorded exchange with the same method, path and params
+// (timestamp and recv_window are ignored); falls back to the same method and path
+func (o *Replay) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+		req.Body.Close()
+	}
+	path := strings.TrimPrefix(req.URL.Path, "/")
+	query := replayKey(stripQuery(req.URL.RawQuery), stripJson(body))
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	match := -1
+	for i, r := range o.records {
+		if o.used[i] || r.Kind != RecordRest || r.Method != req.Method || strings.TrimPrefix(r.Path, "/") != path {
+			continue
+		}
+		if replayKey(r.Query, []byte(r.Request)) == query {
+			match = i
+			break
+		}
+		if match < 0 {
+			match = i
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("replay: no record for %s %s", req.Method, req.URL.Path)
+	}
+	o.used[match] = true
+	r := o.records[match]
+	if r.Status == 0 {
+		return nil, fmt.Errorf("replay: %s", r.Error)
+	}
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", r.Status, http.StatusText(r.Status)),
+		StatusCode: r.Status,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader([]byte(r.Body))),
+		Request:    req,
+	}, nil
+}
+
+// Received frames of the websocket endpoint (matched by url path)
+func (o *Replay) Frames(wsUrl string) [][]byte {
+	path := urlPath(wsUrl)
+	var l [][]byte
+	for _, r := range o.records {
+		if r.Kind == RecordWsRecv && urlPath(r.Url) == path {
+			l = append(l, []byte(r.Body))
+		}
+	}
+	return l
+}
+
+func replayKey(query string, body []byte) string {
+	v, _ := url.ParseQuery(query)
+	var m map[string]any
+	if json.Unmarshal(body, &m) == nil {
+		for k, s := range m {
+			v.Set(k, fmt.Sprint(s))
+		}
+	}
+	v.Del("timestamp")
+	v.Del("recv_window")
+	return v.Encode()
+}
+
+func urlPath(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+	return u.Path
+}
@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// Params that make a creating request idempotent: the exchange rejects
+// a second order (transfer) with the same client id, so it cannot be duplicated
+var IdempotencyKeys = []string{
+	"order_link_id",
+	"orderLinkId",
+	"transfer_id",
+}
+
+type RetryPolicy struct {
+	MaxAttempts int           // Total attempts including the first one
+	MinDelay    time.Duration // Delay before the first retry
+	MaxDelay    time.Duration // Upper bound of delay
+	Multiplier  float64       // Delay growth factor per attempt
+	Jitter      float64       // Random part of delay [0, 1]
+	Codes       []int         // Exchange error codes treated as temporary
+}
+
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinDelay:    time.Millisecond * 200,
+		MaxDelay:    time.Second * 5,
+		Multiplier:  2,
+		Jitter:      0.2,
+		Codes: []int{
+			10016, // Server error
+		},
+	}
+}
+
+// GET requests are always retried; creating requests only with an idempotency key
+func (o *RetryPolicy) Allowed(method string, p Param) bool {
+	if o.MaxAttempts < 2 {
+		return false
+	}
+	if method == http.MethodGet {
+		return true
+	}
+	for _, key := range IdempotencyKeys {
+		if v, ok := p.m[key]; ok && v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *RetryPolicy) TemporaryCode(code int) bool {
+	return slices.Contains(o.Codes, code)
+}
+
+// Delay before retry after the given (1-based) attempt
+func (o *RetryPolicy) Delay(attempt int) time.Duration {
+	d := float64(o.MinDelay) * math.Pow(o.Multiplier, float64(attempt-1))
+	if o.MaxDelay > 0 && d > float64(o.MaxDelay) {
+		d = float64(o.MaxDelay)
+	}
+	if o.Jitter > 0 {
+		d += d * o.Jitter * (rand.Float64()*2 - 1)
+	}
+	return time.Duration(d)
+}
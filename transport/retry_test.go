@@ -0,0 +1,115 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyAllowed(t *testing.T) {
+	type order struct {
+		Symbol      string  `param:"symbol"`
+		OrderLinkID *string `param:"order_link_id"`
+	}
+	type transfer struct {
+		TransferID string `json:"transfer_id"`
+	}
+	id, empty := "x1", ""
+	tests := []struct {
+		name     string
+		attempts int
+		method   string
+		param    any
+		want     bool
+	}{
+		{"get", 3, http.MethodGet, order{Symbol: "BTCUSD"}, true},
+		{"single attempt", 1, http.MethodGet, nil, false},
+		{"post", 3, http.MethodPost, order{Symbol: "BTCUSD"}, false},
+		{"post with link id", 3, http.MethodPost, order{OrderLinkID: &id}, true},
+		{"post with empty link id", 3, http.MethodPost, order{OrderLinkID: &empty}, false},
+		{"post with transfer id", 3, http.MethodPost, transfer{TransferID: "t1"}, true},
+		{"delete", 3, http.MethodDelete, order{Symbol: "BTCUSD"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewRetryPolicy()
+			p.MaxAttempts = tt.attempts
+			if v := p.Allowed(tt.method, NewParam().From(tt.param)); v != tt.want {
+				t.Errorf("allowed %v, want %v", v, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := &RetryPolicy{MinDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond, Multiplier: 2}
+	for attempt, want := range []time.Duration{100, 200, 300, 300} {
+		if d := p.Delay(attempt + 1); d != want*time.Millisecond {
+			t.Errorf("attempt %d: delay %s, want %s", attempt+1, d, want*time.Millisecond)
+		}
+	}
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := p.Delay(2); d < 100*time.Millisecond || d > 300*time.Millisecond {
+			t.Fatalf("delay %s out of jitter range", d)
+		}
+	}
+}
+
+func TestClientRetry(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	policy := &RetryPolicy{MaxAttempts: 3, MinDelay: time.Millisecond, Multiplier: 1}
+	c := NewClient().WithUrl(srv.URL).WithRetry(policy)
+
+	type order struct {
+		OrderLinkID string `param:"order_link_id"`
+	}
+	tests := []struct {
+		name   string
+		method string
+		param  any
+		hits   int32
+	}{
+		{"get", http.MethodGet, nil, 3},
+		{"post", http.MethodPost, nil, 1},
+		{"post with link id", http.MethodPost, order{"x1"}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atomic.StoreInt32(&hits, 0)
+			var ret struct{}
+			err := c.RequestPublic(tt.method, "/v2/public/time", tt.param, &ret)
+			var e *TransportError
+			if !errors.As(err, &e) || e.Status != http.StatusServiceUnavailable {
+				t.Errorf("error %v, want status 503", err)
+			}
+			if n := atomic.LoadInt32(&hits); n != tt.hits {
+				t.Errorf("%d attempts, want %d", n, tt.hits)
+			}
+		})
+	}
+
+	t.Run("canceled in backoff", func(t *testing.T) {
+		atomic.StoreInt32(&hits, 0)
+		c := NewClient().WithUrl(srv.URL).WithRetry(&RetryPolicy{MaxAttempts: 3, MinDelay: time.Minute, Multiplier: 1})
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+		var ret struct{}
+		err := c.RequestPublicContext(ctx, http.MethodGet, "/v2/public/time", nil, &ret)
+		if !IsCanceled(err) {
+			t.Errorf("error %v, want canceled", err)
+		}
+		if n := atomic.LoadInt32(&hits); n != 1 {
+			t.Errorf("%d attempts, want 1", n)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package transport
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Request signer (HMAC secret, RSA key or an external signer process)
+type Signer interface {
+	Sign(payload []byte) (string, error)
+}
+
+type SignerFunc func(payload []byte) (string, error)
+
+func (f SignerFunc) Sign(payload []byte) (string, error) {
+	return f(payload)
+}
+
+// HMAC-SHA256 signer: hex encoded signature
+type HmacSigner struct {
+	secret []byte
+}
+
+func NewHmacSigner(secret string) *HmacSigner {
+	return &HmacSigner{secret: []byte(secret)}
+}
+
+func (o *HmacSigner) Sign(payload []byte) (string, error) {
+	h := hmac.New(sha256.New, o.secret)
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// RSA (PKCS#1 v1.5, SHA256) signer: base64 encoded signature
+type RsaSigner struct {
+	key *rsa.PrivateKey
+}
+
+func NewRsaSigner(key *rsa.PrivateKey) *RsaSigner {
+	return &RsaSigner{key: key}
+}
+
+// Private key in PEM format: PKCS#1 ("RSA PRIVATE KEY") or PKCS#8 ("PRIVATE KEY")
+func NewRsaSignerPem(pemKey []byte) (*RsaSigner, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("rsa signer: pem block not found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return NewRsaSigner(key), nil
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("rsa signer: %v", err)
+	}
+	key, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("rsa signer: key is not rsa")
+	}
+	return NewRsaSigner(key), nil
+}
+
+func (o *RsaSigner) Sign(payload []byte) (string, error) {
+	h := sha256.Sum256(payload)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, o.key, crypto.SHA256, h[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// Websocket auth command (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-websocketauthentication)
+func WsAuth(key string, signer Signer, now time.Time) (any, error) {
+	expires := now.UnixMilli() + 10000
+	signature, err := signer.Sign([]byte(fmt.Sprintf("GET/realtime%d", expires)))
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Name string `json:"op"`
+		Args []any  `json:"args"`
+	}{
+		Name: "auth",
+		Args: []any{
+			key,
+			expires,
+			signature,
+		},
+	}, nil
+}
@@ -6,4 +6,7 @@ const (
 
 	// Mainnet base bytick url:
 	MainBaseByTickUrl = "https://api.bytick.com"
+
+	// Testnet base url:
+	TestBaseUrl = "https://api-testnet.bybit.com"
 )
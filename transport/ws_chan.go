@@ -0,0 +1,116 @@
+package transport
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// What a subscription channel does when its buffer is full
+type WsOverflow int
+
+const (
+	WsBlock      WsOverflow = iota // Wait for the consumer; stalls the socket reader
+	WsDropOldest                   // Drop the oldest buffered value
+	WsConflate                     // Drop all buffered values, keep the latest only
+)
+
+// Channel of subscription values; the socket reader is decoupled from consumers
+// unless the overflow policy is WsBlock:
+//
+//	ch := ws.Public().OrderBook25("BTCUSD").Chan(100, transport.WsDropOldest)
+//	defer ch.Close()
+//	for v := range ch.C() { ... }
+type WsChan[T any] struct {
+	c           chan T
+	done        chan struct{}
+	overflow    WsOverflow
+	mutex       sync.Mutex
+	once        sync.Once
+	closed      bool
+	dropped     uint64
+	unsubscribe func()
+}
+
+func NewWsChan[T any](size int, overflow WsOverflow, unsubscribe func()) *WsChan[T] {
+	if size < 1 && overflow != WsBlock {
+		size = 1
+	}
+	return &WsChan[T]{
+		c:           make(chan T, size),
+		done:        make(chan struct{}),
+		overflow:    overflow,
+		unsubscribe: unsubscribe,
+	}
+}
+
+func (o *WsChan[T]) C() <-chan T {
+	return o.c
+}
+
+// Values dropped by overflow policy
+func (o *WsChan[T]) Dropped() uint64 {
+	return atomic.LoadUint64(&o.dropped)
+}
+
+// Unsubscribes and closes the channel
+func (o *WsChan[T]) Close() {
+	o.once.Do(func() {
+		o.unsubscribe()
+		close(o.done)
+		o.mutex.Lock()
+		defer o.mutex.Unlock()
+		o.closed = true
+		close(o.c)
+	})
+}
+
+// Called by the subscription with every value
+func (o *WsChan[T]) Push(v T) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if o.closed {
+		return
+	}
+	switch o.overflow {
+	case WsBlock:
+		select {
+		case o.c <- v:
+		case <-o.done:
+		}
+		return
+	case WsConflate:
+		o.drain(len(o.c))
+	}
+	for {
+		select {
+		case o.c <- v:
+			return
+		default:
+			o.drain(1)
+		}
+	}
+}
+
+func (o *WsChan[T]) drain(n int) {
+	for i := 0; i < n; i++ {
+		select {
+		case <-o.c:
+			atomic.AddUint64(&o.dropped, 1)
+		default:
+			return
+		}
+	}
+}
+
+// Copy of slice with its own array: values merged in place by deltas
+// are not shared with the channel consumer
+func WsClone[T any](v T) T {
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() == reflect.Slice && !rv.IsNil() {
+		c := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(c, rv)
+		rv.Set(c)
+	}
+	return v
+}
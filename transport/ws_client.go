@@ -2,6 +2,8 @@ package transport
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/msw-x/moon/app"
@@ -12,6 +14,8 @@ type WsClient struct {
 	ws               *WsConn
 	heartbeatTimeout time.Duration
 	onMessage        func(string, []byte)
+	onResubscribe    func(topic string)
+	onError          func(*WsError)
 }
 
 func NewWsClient(url string) *WsClient {
@@ -34,6 +38,11 @@ func (o *WsClient) Conf() *WsConf {
 	return o.ws.Conf()
 }
 
+func (o *WsClient) WithUrl(url string) *WsClient {
+	o.ws.SetUrl(url)
+	return o
+}
+
 func (o *WsClient) WithProxy(proxy string) *WsClient {
 	o.Conf().SetProxy(proxy)
 	return o
@@ -58,12 +67,58 @@ func (o *WsClient) Run() {
 			o.ping()
 		}
 	})
+	if w := o.Conf().Watchdog; w != nil {
+		app.Go(func() {
+			for o.ws.Do() {
+				o.ws.Sleep(w.period)
+				if o.ws.Do() && o.ws.Connected() {
+					o.checkStale(w)
+				}
+			}
+		})
+	}
+}
+
+func (o *WsClient) checkStale(w *WsWatchdog) {
+	l, actions := w.check(time.Now())
+	for i, v := range l {
+		switch actions[i] {
+		case WsStaleResubscribe:
+			if o.onResubscribe != nil {
+				o.ws.log.Warningf("stale topic[%s] for %v: resubscribe", v.Topic, v.Gap)
+				o.onResubscribe(v.Topic)
+				break
+			}
+			fallthrough
+		case WsStaleReconnect:
+			o.ws.log.Warningf("stale topic[%s] for %v: reconnect", v.Topic, v.Gap)
+			o.ws.Reconnect(fmt.Errorf("%w: topic[%s] for %v", ErrStaleStream, v.Topic, v.Gap))
+			return
+		}
+	}
 }
 
 func (o *WsClient) SetOnMessage(onMessage func(string, []byte)) {
 	o.onMessage = onMessage
 }
 
+// Receives errors of frame processing instead of the log only
+func (o *WsClient) SetOnError(onError func(*WsError)) {
+	o.onError = onError
+}
+
+func (o *WsClient) ReportError(err *WsError) {
+	o.ws.log.Error(err)
+	if o.onError != nil {
+		o.onError(err)
+	}
+}
+
+// Repeats subscription of a stale topic; without it stale topics cause reconnect
+func (o *WsClient) SetOnResubscribe(onResubscribe func(topic string)) {
+	o.onResubscribe = onResubscribe
+}
+
 func (o *WsClient) SetOnConnected(onConnected func()) {
 	o.ws.SetOnConnected(onConnected)
 }
@@ -72,6 +127,10 @@ func (o *WsClient) SetOnDisconnected(onDisconnected func()) {
 	o.ws.SetOnDisconnected(onDisconnected)
 }
 
+func (o *WsClient) NotifyAuth(success bool) {
+	o.ws.NotifyAuth(success)
+}
+
 func (o *WsClient) Send(cmd any) bool {
 	return o.ws.Send(cmd)
 }
@@ -84,10 +143,11 @@ func (o *WsClient) ping() bool {
 	})
 }
 
+// Message name is the first key of the object (of the first object of an array)
 func (o *WsClient) processMessage(msg []byte) {
 	prefix := []byte(`{"`)
-	if bytes.HasPrefix(msg, prefix) {
-		m := bytes.TrimPrefix(msg, prefix)
+	if m := bytes.TrimLeft(msg, "["); bytes.HasPrefix(m, prefix) {
+		m = bytes.TrimPrefix(m, prefix)
 		i := bytes.IndexByte(m, '"')
 		if i > -1 {
 			name := string(m[:i])
@@ -97,5 +157,5 @@ func (o *WsClient) processMessage(msg []byte) {
 			return
 		}
 	}
-	panic("message type not detected")
+	o.ReportError(NewWsError(ErrWsProtocol, "", msg, errors.New("message type not detected")))
 }
@@ -2,6 +2,7 @@ package transport
 
 import (
 	"fmt"
+	"math/rand"
 	"net/url"
 	"time"
 )
@@ -9,17 +10,57 @@ import (
 type WsConf struct {
 	Proxy            *url.URL
 	HandshakeTimeout time.Duration
+	DialTimeout      time.Duration // Whole dial: connect, proxy, tls and handshake
 	ReadTimeout      time.Duration
 	WriteTimeout     time.Duration
+	Reconnect        WsReconnect
+	Events           chan<- WsEvent // Lifecycle events; dropped when the channel is full
+	Watchdog         *WsWatchdog    // Stale subscriptions detector
 	LogRecv          bool
 	LogSent          bool
+	Recorder         *Recorder // Write sent and received frames
+	Replay           *Replay   // Play recorded frames instead of connecting
+}
+
+// Exponential reconnect backoff. A failed dial or a connection lost sooner than Max
+// after it was established counts as a failed attempt; a connection lasting longer
+// resets the counter and is redialed at once
+type WsReconnect struct {
+	Min      time.Duration // Delay after the first failed attempt
+	Max      time.Duration // Delay limit
+	Jitter   float64       // Random part of delay: 0.2 is ±20%
+	Attempts int           // Failed attempts in a row before giving up; zero is unlimited
+}
+
+// Delay after attempt failed attempts in a row
+func (o WsReconnect) Delay(attempt int) time.Duration {
+	if attempt <= 0 {
+		return 0
+	}
+	d := o.Min
+	for i := 1; i < attempt && d < o.Max; i++ {
+		d *= 2
+	}
+	if d > o.Max {
+		d = o.Max
+	}
+	if o.Jitter > 0 {
+		d += time.Duration(float64(d) * o.Jitter * (2*rand.Float64() - 1))
+	}
+	return d
 }
 
 func NewWsConf() *WsConf {
 	return &WsConf{
 		HandshakeTimeout: time.Second * 10,
+		DialTimeout:      time.Second * 20,
 		ReadTimeout:      time.Second * 30,
 		WriteTimeout:     time.Second * 5,
+		Reconnect: WsReconnect{
+			Min:    time.Second,
+			Max:    time.Minute,
+			Jitter: 0.2,
+		},
 	}
 }
 
@@ -1,6 +1,7 @@
 package transport
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -26,6 +27,8 @@ type WsConn struct {
 	onMessage      func([]byte)
 	onConnected    func()
 	onDisconnected func()
+	replaying      bool
+	cause          error
 }
 
 func NewWsConn(url string) *WsConn {
@@ -40,8 +43,8 @@ func NewWsConn(url string) *WsConn {
 func (o *WsConn) Shutdown() {
 	o.log.Debug("shutdown...")
 	o.do.Cancel()
-	if o.Connected() {
-		o.ws.Close()
+	if ws := o.ws; ws != nil {
+		ws.Close()
 	}
 	o.log.Debug("stop")
 	o.do.Stop()
@@ -53,8 +56,17 @@ func (o *WsConn) WithLog(log *ulog.Log) *WsConn {
 	return o
 }
 
+// Endpoint url; takes effect on the next dial
+func (o *WsConn) SetUrl(url string) {
+	o.url = url
+}
+
+func (o *WsConn) Url() string {
+	return o.url
+}
+
 func (o *WsConn) Connected() bool {
-	return o.ws != nil
+	return o.ws != nil || o.replaying
 }
 
 func (o *WsConn) Conf() *WsConf {
@@ -102,12 +114,21 @@ func (o *WsConn) Send(v any) bool {
 	err = o.write(bin)
 	if err != nil {
 		o.log.Errorf("send: %v", err)
-		o.setConnected(nil)
+		o.fail(err)
 		return false
 	}
 	return true
 }
 
+// Reports auth result of the connection to lifecycle events
+func (o *WsConn) NotifyAuth(success bool) {
+	if success {
+		o.event(WsEvent{Kind: WsAuthenticated})
+	} else {
+		o.event(WsEvent{Kind: WsAuthFailed, Err: ErrAuth})
+	}
+}
+
 func (o *WsConn) run() {
 	if o.url == "" {
 		o.log.Warning("disabled")
@@ -120,28 +141,52 @@ func (o *WsConn) run() {
 	defer o.log.Debug("completed")
 	defer o.do.Notify()
 	o.log.Debug("run")
+	if o.conf.Replay != nil {
+		o.replay()
+		return
+	}
+	attempt := 0
 	for o.do.Do() {
-		o.connectAndRun()
+		attempt = o.connectAndRun(attempt)
 	}
 }
 
-func (o *WsConn) connectAndRun() {
-	o.log.Info("dial:", o.url)
-	dialer := websocket.Dialer{
-		HandshakeTimeout: o.conf.HandshakeTimeout,
+// Feed recorded frames to the message handler; sent frames are only recorded
+func (o *WsConn) replay() {
+	frames := o.conf.Replay.Frames(o.url)
+	o.log.Info("replay:", len(frames), "frames")
+	o.replaying = true
+	o.event(WsEvent{Kind: WsConnected})
+	if o.onConnected != nil {
+		o.onConnected()
 	}
-	if o.conf.Proxy != nil {
-		o.log.Debug("proxy:", o.conf.Proxy)
-		dialer.Proxy = http.ProxyURL(o.conf.Proxy)
+	for _, msg := range frames {
+		if !o.do.Do() {
+			break
+		}
+		o.msg = msg
+		o.processMessage()
+	}
+	for o.do.Do() {
+		o.do.Sleep(time.Second)
 	}
-	c, _, err := dialer.Dial(o.url, nil)
+	o.replaying = false
+}
+
+// Dials and reads until disconnected; returns failed attempts in a row
+func (o *WsConn) connectAndRun(attempt int) int {
+	o.log.Info("dial:", o.url)
+	o.event(WsEvent{Kind: WsDialing, Attempt: attempt})
+	c, err := o.dial()
 	if err != nil {
 		o.log.Error("dial:", err)
-		o.do.Sleep(time.Second * 10)
-		return
+		return o.reconnect(attempt+1, err)
 	}
-	defer c.Close()
-	defer o.setConnected(nil)
+	start := time.Now()
+	o.mutex.Lock()
+	o.cause = nil
+	o.mutex.Unlock()
+	o.event(WsEvent{Kind: WsConnected, Attempt: attempt})
 	o.setConnected(c)
 	o.ws.SetPongHandler(func(text string) error {
 		o.log.Debug("pong")
@@ -156,6 +201,7 @@ func (o *WsConn) connectAndRun() {
 		if err != nil {
 			if o.do.Do() {
 				o.log.Error("read:", err)
+				o.fail(err)
 			}
 			break
 		}
@@ -166,6 +212,88 @@ func (o *WsConn) connectAndRun() {
 			}
 		}
 	}
+	o.setConnected(nil)
+	c.Close()
+	if time.Since(start) > o.conf.Reconnect.Max {
+		attempt = 0
+	} else {
+		attempt++
+	}
+	o.mutex.Lock()
+	err = o.cause
+	o.mutex.Unlock()
+	return o.reconnect(attempt, err)
+}
+
+func (o *WsConn) dial() (*websocket.Conn, error) {
+	dialer := websocket.Dialer{
+		HandshakeTimeout: o.conf.HandshakeTimeout,
+	}
+	if o.conf.Proxy != nil {
+		o.log.Debug("proxy:", o.conf.Proxy)
+		dialer.Proxy = http.ProxyURL(o.conf.Proxy)
+	}
+	ctx := context.Background()
+	if o.conf.DialTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.conf.DialTimeout)
+		defer cancel()
+	}
+	c, _, err := dialer.DialContext(ctx, o.url, nil)
+	return c, err
+}
+
+// Waits before the next dial, or gives up once attempts are exhausted
+func (o *WsConn) reconnect(attempt int, err error) int {
+	if !o.do.Do() {
+		o.event(WsEvent{Kind: WsDisconnected})
+		return attempt
+	}
+	if n := o.conf.Reconnect.Attempts; n > 0 && attempt >= n {
+		o.event(WsEvent{Kind: WsDisconnected, Attempt: attempt, Err: err})
+		o.log.Errorf("giving up after %d attempts", attempt)
+		o.event(WsEvent{Kind: WsGivingUp, Attempt: attempt, Err: err})
+		o.do.Cancel()
+		return attempt
+	}
+	delay := o.conf.Reconnect.Delay(attempt)
+	o.event(WsEvent{Kind: WsDisconnected, Attempt: attempt, Delay: delay, Err: err})
+	if delay > 0 {
+		o.log.Info("reconnect in", delay)
+		o.do.Sleep(delay)
+	}
+	return attempt
+}
+
+// Drops connection to dial again; err is the cause of disconnect
+func (o *WsConn) Reconnect(err error) {
+	o.log.Info("reconnect:", err)
+	o.fail(err)
+}
+
+// Drops connection; the first error is the cause of disconnect
+func (o *WsConn) fail(err error) {
+	o.mutex.Lock()
+	if o.cause == nil {
+		o.cause = err
+	}
+	o.mutex.Unlock()
+	if ws := o.ws; ws != nil {
+		ws.Close()
+	}
+	o.setConnected(nil)
+}
+
+func (o *WsConn) event(e WsEvent) {
+	if o.conf.Events == nil {
+		return
+	}
+	e.Url = o.url
+	e.Time = time.Now()
+	select {
+	case o.conf.Events <- e:
+	default:
+	}
 }
 
 func (o *WsConn) setConnected(ws *websocket.Conn) {
@@ -173,6 +301,7 @@ func (o *WsConn) setConnected(ws *websocket.Conn) {
 		o.ws = ws
 		if ws == nil {
 			o.log.Info("disconnected")
+			o.conf.Watchdog.Reset()
 			if o.onDisconnected != nil {
 				o.onDisconnected()
 			}
@@ -203,12 +332,21 @@ func (o *WsConn) read() ([]byte, error) {
 	if o.conf.LogRecv && o.do.Do() {
 		o.log.Debugf("recv: %d B: %s", len(msg), string(msg))
 	}
+	if err == nil && o.conf.Recorder != nil {
+		o.conf.Recorder.ws(RecordWsRecv, o.url, msg)
+	}
 	return msg, err
 }
 
 func (o *WsConn) write(buf []byte) error {
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
+	if o.conf.Recorder != nil {
+		o.conf.Recorder.ws(RecordWsSent, o.url, buf)
+	}
+	if o.replaying {
+		return nil
+	}
 	ws := o.ws
 	if ws == nil {
 		return errors.New("empty socket")
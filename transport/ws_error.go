@@ -0,0 +1,44 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Websocket error categories, besides ErrDecode
+var (
+	ErrWsProtocol   = errors.New("websocket protocol") // Unknown message or response, failed request
+	ErrUnknownTopic = errors.New("unknown topic")      // Topic without subscription
+)
+
+// Error of processing a received frame
+type WsError struct {
+	Kind  error  // ErrDecode, ErrWsProtocol or ErrUnknownTopic
+	Name  string // Message name or topic
+	Frame []byte // Raw frame
+	Err   error
+}
+
+func NewWsError(kind error, name string, frame []byte, err error) *WsError {
+	return &WsError{
+		Kind:  kind,
+		Name:  name,
+		Frame: frame,
+		Err:   err,
+	}
+}
+
+func (o *WsError) Error() string {
+	if o.Err == nil {
+		return fmt.Sprintf("%v [%s]", o.Kind, o.Name)
+	}
+	return fmt.Sprintf("%v [%s]: %v", o.Kind, o.Name, o.Err)
+}
+
+func (o *WsError) Unwrap() error {
+	return o.Err
+}
+
+func (o *WsError) Is(target error) bool {
+	return target == o.Kind
+}
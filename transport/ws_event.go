@@ -0,0 +1,41 @@
+package transport
+
+import (
+	"fmt"
+	"time"
+)
+
+type WsEventKind string
+
+const (
+	WsDialing       WsEventKind = "dialing"
+	WsConnected     WsEventKind = "connected"
+	WsAuthenticated WsEventKind = "authenticated"
+	WsAuthFailed    WsEventKind = "auth failed"
+	WsDisconnected  WsEventKind = "disconnected"
+	WsGivingUp      WsEventKind = "giving up"
+)
+
+// Connection lifecycle event
+type WsEvent struct {
+	Kind    WsEventKind
+	Url     string
+	Time    time.Time
+	Attempt int           // Consecutive failed attempts (dial failures and short connections)
+	Delay   time.Duration // Delay before the next dial (disconnected, dial failure)
+	Err     error         // Cause of disconnect or dial failure; nil on shutdown
+}
+
+func (o WsEvent) String() string {
+	s := fmt.Sprintf("%s %s", o.Kind, o.Url)
+	if o.Attempt > 0 {
+		s += fmt.Sprintf(" attempt[%d]", o.Attempt)
+	}
+	if o.Delay > 0 {
+		s += fmt.Sprintf(" delay[%v]", o.Delay)
+	}
+	if o.Err != nil {
+		s += fmt.Sprintf(": %v", o.Err)
+	}
+	return s
+}
@@ -0,0 +1,109 @@
+package transport
+
+import "sync"
+
+// Topics of a connection pool. A topic is placed on the least loaded healthy connection
+// with less than limit topics (zero is unlimited); without a healthy one, on any connection
+// with room, to be subscribed once it connects
+type WsShards struct {
+	mutex  sync.Mutex
+	limit  int
+	load   []int
+	topics map[string]int
+}
+
+// Topic moved between connections
+type WsMove struct {
+	Topic string
+	From  int
+	To    int
+}
+
+func NewWsShards(connections int, limit int) *WsShards {
+	return &WsShards{
+		limit:  limit,
+		load:   make([]int, connections),
+		topics: make(map[string]int),
+	}
+}
+
+func (o *WsShards) Connections() int {
+	return len(o.load)
+}
+
+// Topics placed on connection
+func (o *WsShards) Load(connection int) int {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.load[connection]
+}
+
+func (o *WsShards) Connection(topic string) (int, bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	i, ok := o.topics[topic]
+	return i, ok
+}
+
+// Connection of new topic; false if all connections are full
+func (o *WsShards) Place(topic string, healthy func(int) bool) (int, bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if i, ok := o.topics[topic]; ok {
+		return i, true
+	}
+	i := o.choose(-1, healthy)
+	if i < 0 {
+		return i, false
+	}
+	o.topics[topic] = i
+	o.load[i]++
+	return i, true
+}
+
+func (o *WsShards) Remove(topic string) (int, bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	i, ok := o.topics[topic]
+	if ok {
+		delete(o.topics, topic)
+		o.load[i]--
+	}
+	return i, ok
+}
+
+// Moves topics of a dropped connection to healthy ones with room; topics without room stay
+func (o *WsShards) Evacuate(connection int, healthy func(int) bool) (l []WsMove) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	for topic, i := range o.topics {
+		if i != connection {
+			continue
+		}
+		to := o.choose(connection, healthy)
+		if to < 0 || !healthy(to) {
+			break
+		}
+		o.topics[topic] = to
+		o.load[connection]--
+		o.load[to]++
+		l = append(l, WsMove{Topic: topic, From: connection, To: to})
+	}
+	return
+}
+
+func (o *WsShards) choose(exclude int, healthy func(int) bool) int {
+	best := -1
+	bestHealthy := false
+	for i, load := range o.load {
+		if i == exclude || (o.limit > 0 && load >= o.limit) {
+			continue
+		}
+		h := healthy(i)
+		if best < 0 || (h && !bestHealthy) || (h == bestHealthy && load < o.load[best]) {
+			best = i
+			bestHealthy = h
+		}
+	}
+	return best
+}
@@ -0,0 +1,147 @@
+package transport
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrStaleStream = errors.New("stale websocket stream")
+
+type WsStaleAction int
+
+const (
+	WsStaleResubscribe WsStaleAction = iota // Unsubscribe and subscribe the topic again
+	WsStaleReconnect                        // Drop connection; all topics are subscribed again
+	WsStaleIgnore
+)
+
+// Subscription without messages for longer than its max gap
+type WsStale struct {
+	Topic string
+	Last  time.Time // Last message (or subscription)
+	Gap   time.Duration
+}
+
+// Detects subscriptions gone silent while the connection is alive:
+//
+//	ws.Conf().Watchdog = transport.NewWsWatchdog().
+//		WithGap("orderBookL2_25", 5*time.Second).
+//		WithGap("trade.BTCUSDT", time.Minute)
+//
+// Max gap is set for a full topic or a topic name (the part before the first dot);
+// topics without a gap are not watched. One watchdog serves one connection
+type WsWatchdog struct {
+	mutex   sync.Mutex
+	gaps    map[string]time.Duration
+	last    map[string]time.Time
+	action  WsStaleAction
+	onStale func(WsStale) WsStaleAction
+	period  time.Duration
+}
+
+func NewWsWatchdog() *WsWatchdog {
+	return &WsWatchdog{
+		gaps:   make(map[string]time.Duration),
+		last:   make(map[string]time.Time),
+		action: WsStaleResubscribe,
+		period: time.Second,
+	}
+}
+
+func (o *WsWatchdog) WithGap(topic string, gap time.Duration) *WsWatchdog {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.gaps[topic] = gap
+	return o
+}
+
+// Action without handler, resubscribe by default
+func (o *WsWatchdog) WithAction(action WsStaleAction) *WsWatchdog {
+	o.action = action
+	return o
+}
+
+// Check period, one second by default
+func (o *WsWatchdog) WithPeriod(period time.Duration) *WsWatchdog {
+	o.period = period
+	return o
+}
+
+// Handler of stale topic decides what to do with it
+func (o *WsWatchdog) SetOnStale(onStale func(WsStale) WsStaleAction) {
+	o.onStale = onStale
+}
+
+// Subscription sent; methods are no-op on nil watchdog
+func (o *WsWatchdog) Watch(topic string) {
+	if o == nil {
+		return
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if o.gap(topic) > 0 {
+		o.last[topic] = time.Now()
+	}
+}
+
+func (o *WsWatchdog) Forget(topic string) {
+	if o == nil {
+		return
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	delete(o.last, topic)
+}
+
+// Message of topic received
+func (o *WsWatchdog) Touch(topic string) {
+	if o == nil {
+		return
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if _, ok := o.last[topic]; ok {
+		o.last[topic] = time.Now()
+	}
+}
+
+// Connection lost: topics are watched again once subscribed
+func (o *WsWatchdog) Reset() {
+	if o == nil {
+		return
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.last = make(map[string]time.Time)
+}
+
+// Stale topics and actions; a reported topic is reported again after another gap
+func (o *WsWatchdog) check(now time.Time) (l []WsStale, actions []WsStaleAction) {
+	o.mutex.Lock()
+	for topic, last := range o.last {
+		gap := o.gap(topic)
+		if now.Sub(last) > gap {
+			l = append(l, WsStale{Topic: topic, Last: last, Gap: gap})
+			o.last[topic] = now
+		}
+	}
+	o.mutex.Unlock()
+	for _, v := range l {
+		action := o.action
+		if o.onStale != nil {
+			action = o.onStale(v)
+		}
+		actions = append(actions, action)
+	}
+	return
+}
+
+func (o *WsWatchdog) gap(topic string) time.Duration {
+	if gap, ok := o.gaps[topic]; ok {
+		return gap
+	}
+	name, _, _ := strings.Cut(topic, ".")
+	return o.gaps[name]
+}
@@ -1,22 +1,28 @@
 // Active Orders (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-activeorders)
 package uperpetual
 
+import (
+	"context"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 // Place Active Order (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-placeactive)
 type PlaceActiveOrder struct {
-	Side           Side          `param:"side"`
-	Symbol         string        `param:"symbol"`
-	OrderType      OrderType     `param:"order_type"`
-	Qty            int           `param:"qty"`
-	TimeInForce    TimeInForce   `param:"time_in_force"`
-	ReduceOnly     bool          `param:"reduce_only"`
-	CloseOnTrigger bool          `param:"close_on_trigger"`
-	Price          *float64      `param:"price"`
-	OrderLinkID    *string       `param:"order_link_id"`
-	TakeProfit     *float64      `param:"take_profit"`
-	StopLoss       *float64      `param:"stop_loss"`
-	TpTrigger      *TriggerPrice `param:"tp_trigger_by"`
-	SlTrigger      *TriggerPrice `param:"sl_trigger_by"`
-	PositionIdx    *PositionIdx  `param:"position_idx"`
+	Side           Side               `param:"side"`
+	Symbol         string             `param:"symbol"`
+	OrderType      OrderType          `param:"order_type"`
+	Qty            transport.Decimal  `param:"qty"`
+	TimeInForce    TimeInForce        `param:"time_in_force"`
+	ReduceOnly     bool               `param:"reduce_only"`
+	CloseOnTrigger bool               `param:"close_on_trigger"`
+	Price          *transport.Decimal `param:"price"`
+	OrderLinkID    *string            `param:"order_link_id"`
+	TakeProfit     *transport.Decimal `param:"take_profit"`
+	StopLoss       *transport.Decimal `param:"stop_loss"`
+	TpTrigger      *TriggerPrice      `param:"tp_trigger_by"`
+	SlTrigger      *TriggerPrice      `param:"sl_trigger_by"`
+	PositionIdx    *PositionIdx       `param:"position_idx"`
 }
 
 type OrderCreated struct {
@@ -25,7 +31,13 @@ type OrderCreated struct {
 }
 
 func (this *PlaceActiveOrder) Do(client *Client) (OrderCreated, error) {
-	return Post[OrderCreated](client, "order/create", this)
+	// Rounded copy, the caller's order is left as is
+	order := *this
+	order.Price = transport.Clone(this.Price)
+	if err := client.prepareOrder(order.Symbol, &order.Qty, order.Price); err != nil {
+		return OrderCreated{}, err
+	}
+	return Post[OrderCreated](client, "order/create", &order)
 }
 
 func (this *Client) PlaceActiveOrder(v PlaceActiveOrder) (OrderCreated, error) {
@@ -47,6 +59,15 @@ func (this OrderList) Do(client *Client) (OrderListResult, error) {
 	return Get[OrderListResult](client, "order/list", this)
 }
 
+// Iterator over all orders, page by page
+func (this OrderList) Iter(client *Client) *transport.Pager[Order] {
+	return transport.NewPager(client.Context(), transport.PageNumber(transport.Deref(this.Page), transport.Deref(this.Limit), func(ctx context.Context, page int) ([]Order, error) {
+		this.Page = &page
+		r, err := GetContext[OrderListResult](ctx, client, "order/list", this)
+		return r.Items, err
+	}))
+}
+
 type OrderListResult struct {
 	Items       []Order `json:"data"`
 	CurrentPage int     `json:"current_page"`
@@ -90,15 +111,15 @@ func (this *Client) CancelAllOrders(symbol string) ([]string, error) {
 
 // Replace Active Order (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-replaceactive)
 type ReplaceOrder struct {
-	Symbol      string        `param:"symbol"`
-	OrderID     *string       `param:"order_id"`
-	OrderLinkID *string       `param:"order_link_id"`
-	Qty         *int          `param:"p_r_qty"`
-	Price       *string       `param:"p_r_price"`
-	TakeProfit  *float64      `param:"take_profit"`
-	StopLoss    *float64      `param:"stop_loss"`
-	TpTrigger   *TriggerPrice `param:"tp_trigger_by"`
-	SlTrigger   *TriggerPrice `param:"sl_trigger_by"`
+	Symbol      string             `param:"symbol"`
+	OrderID     *string            `param:"order_id"`
+	OrderLinkID *string            `param:"order_link_id"`
+	Qty         *transport.Decimal `param:"p_r_qty"`
+	Price       *transport.Decimal `param:"p_r_price"`
+	TakeProfit  *transport.Decimal `param:"take_profit"`
+	StopLoss    *transport.Decimal `param:"stop_loss"`
+	TpTrigger   *TriggerPrice      `param:"tp_trigger_by"`
+	SlTrigger   *TriggerPrice      `param:"sl_trigger_by"`
 }
 
 func (this ReplaceOrder) Do(client *Client) (string, error) {
@@ -134,28 +155,28 @@ func (this QueryOrder) Do(client *Client) ([]Order, error) {
 }
 
 type Order struct {
-	OrderID        string       `json:"order_id"`
-	UserID         int          `json:"user_id"`
-	Symbol         string       `json:"symbol"`
-	Side           Side         `json:"side"`
-	OrderType      OrderType    `json:"order_type"`
-	Price          float64      `json:"price"`
-	Qty            int          `json:"qty"`
-	TimeInForce    TimeInForce  `json:"time_in_force"`
-	OrderStatus    OrderStatus  `json:"order_status"`
-	LastExecPrice  string       `json:"last_exec_price"`
-	CumExecQty     float64      `json:"cum_exec_qty"`
-	CumExecValue   float64      `json:"cum_exec_value"`
-	CumExecFee     float64      `json:"cum_exec_fee"`
-	ReduceOnly     bool         `json:"reduce_only"`
-	CloseOnTrigger bool         `json:"close_on_trigger"`
-	OrderLinkID    string       `json:"order_link_id"`
-	CreatedTime    string       `json:"created_time"`
-	UpdatedTime    string       `json:"updated_time"`
-	TakeProfit     float64      `json:"take_profit"`
-	StopLoss       float64      `json:"stop_loss"`
-	TpTrigger      TriggerPrice `json:"tp_trigger_by"`
-	SlTrigger      TriggerPrice `json:"sl_trigger_by"`
+	OrderID        string            `json:"order_id"`
+	UserID         int               `json:"user_id"`
+	Symbol         string            `json:"symbol"`
+	Side           Side              `json:"side"`
+	OrderType      OrderType         `json:"order_type"`
+	Price          transport.Decimal `json:"price"`
+	Qty            transport.Decimal `json:"qty"`
+	TimeInForce    TimeInForce       `json:"time_in_force"`
+	OrderStatus    OrderStatus       `json:"order_status"`
+	LastExecPrice  transport.Decimal `json:"last_exec_price"`
+	CumExecQty     transport.Decimal `json:"cum_exec_qty"`
+	CumExecValue   transport.Decimal `json:"cum_exec_value"`
+	CumExecFee     transport.Decimal `json:"cum_exec_fee"`
+	ReduceOnly     bool              `json:"reduce_only"`
+	CloseOnTrigger bool              `json:"close_on_trigger"`
+	OrderLinkID    string            `json:"order_link_id"`
+	CreatedTime    transport.Time    `json:"created_time"`
+	UpdatedTime    transport.Time    `json:"updated_time"`
+	TakeProfit     transport.Decimal `json:"take_profit"`
+	StopLoss       transport.Decimal `json:"stop_loss"`
+	TpTrigger      TriggerPrice      `json:"tp_trigger_by"`
+	SlTrigger      TriggerPrice      `json:"sl_trigger_by"`
 }
 
 func (this *Client) QueryOrder(v QueryOrder) ([]Order, error) {
@@ -1,25 +1,31 @@
 // Conditional Orders (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-conditionalorders)
 package uperpetual
 
+import (
+	"context"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 // Place Conditional Order (https://bybit-exchange.github.io/docs/futuresV2/inverse/#t-placecond)
 type PlaceConditionalOrder struct {
-	Side           Side          `param:"side"`
-	Symbol         string        `param:"symbol"`
-	OrderType      OrderType     `param:"order_type"`
-	Qty            int           `param:"qty"`
-	BasePrice      string        `param:"base_price"`
-	StopPx         string        `param:"stop_px"`
-	TimeInForce    TimeInForce   `param:"time_in_force"`
-	TriggerBy      TriggerPrice  `param:"trigger_by"`
-	ReduceOnly     bool          `param:"reduce_only"`
-	CloseOnTrigger bool          `param:"close_on_trigger"`
-	Price          *float64      `param:"price"`
-	OrderLinkID    *string       `param:"order_link_id"`
-	TakeProfit     *float64      `param:"take_profit"`
-	StopLoss       *float64      `param:"stop_loss"`
-	TpTrigger      *TriggerPrice `param:"tp_trigger_by"`
-	SlTrigger      *TriggerPrice `param:"sl_trigger_by"`
-	PositionIdx    *PositionIdx  `param:"position_idx"`
+	Side           Side               `param:"side"`
+	Symbol         string             `param:"symbol"`
+	OrderType      OrderType          `param:"order_type"`
+	Qty            transport.Decimal  `param:"qty"`
+	BasePrice      transport.Decimal  `param:"base_price"`
+	StopPx         transport.Decimal  `param:"stop_px"`
+	TimeInForce    TimeInForce        `param:"time_in_force"`
+	TriggerBy      TriggerPrice       `param:"trigger_by"`
+	ReduceOnly     bool               `param:"reduce_only"`
+	CloseOnTrigger bool               `param:"close_on_trigger"`
+	Price          *transport.Decimal `param:"price"`
+	OrderLinkID    *string            `param:"order_link_id"`
+	TakeProfit     *transport.Decimal `param:"take_profit"`
+	StopLoss       *transport.Decimal `param:"stop_loss"`
+	TpTrigger      *TriggerPrice      `param:"tp_trigger_by"`
+	SlTrigger      *TriggerPrice      `param:"sl_trigger_by"`
+	PositionIdx    *PositionIdx       `param:"position_idx"`
 }
 
 type ConditionalOrderCreated struct {
@@ -28,7 +34,13 @@ type ConditionalOrderCreated struct {
 }
 
 func (this *PlaceConditionalOrder) Do(client *Client) (ConditionalOrderCreated, error) {
-	return Post[ConditionalOrderCreated](client, "stop-order/create", this)
+	// Rounded copy, the caller's order is left as is
+	order := *this
+	order.Price = transport.Clone(this.Price)
+	if err := client.prepareOrder(order.Symbol, &order.Qty, order.Price, &order.StopPx); err != nil {
+		return ConditionalOrderCreated{}, err
+	}
+	return Post[ConditionalOrderCreated](client, "stop-order/create", &order)
 }
 
 func (this *Client) PlaceConditionalOrder(v PlaceConditionalOrder) (ConditionalOrderCreated, error) {
@@ -40,31 +52,40 @@ func (this OrderList) DoConditional(client *Client) (ConditionalOrderListResult,
 	return Get[ConditionalOrderListResult](client, "stop-order/list", this)
 }
 
+// Iterator over all conditional orders, page by page
+func (this OrderList) IterConditional(client *Client) *transport.Pager[ConditionalOrderItem] {
+	return transport.NewPager(client.Context(), transport.PageNumber(transport.Deref(this.Page), transport.Deref(this.Limit), func(ctx context.Context, page int) ([]ConditionalOrderItem, error) {
+		this.Page = &page
+		r, err := GetContext[ConditionalOrderListResult](ctx, client, "stop-order/list", this)
+		return r.Items, err
+	}))
+}
+
 type ConditionalOrderListResult struct {
 	Items       []ConditionalOrderItem `json:"data"`
 	CurrentPage int                    `json:"current_page"`
 }
 
 type ConditionalOrderItem struct {
-	OrderID      string       `json:"stop_order_id"`
-	UserID       int          `json:"user_id"`
-	Symbol       string       `json:"symbol"`
-	Side         Side         `json:"side"`
-	OrderType    OrderType    `json:"order_type"`
-	Price        float64      `json:"price"`
-	Qty          int          `json:"qty"`
-	TimeInForce  TimeInForce  `json:"time_in_force"`
-	OrderStatus  OrderStatus  `json:"order_status"`
-	TriggerPrice float64      `json:"trigger_price"`
-	OrderLinkID  string       `json:"order_link_id"`
-	CreatedTime  string       `json:"created_time"`
-	UpdatedTime  string       `json:"updated_time"`
-	BasePrice    float64      `json:"base_price"`
-	TriggerBy    TriggerPrice `json:"trigger_by"`
-	TpTrigger    TriggerPrice `json:"tp_trigger_by"`
-	SlTrigger    TriggerPrice `json:"sl_trigger_by"`
-	TakeProfit   float64      `json:"take_profit"`
-	StopLoss     float64      `json:"stop_loss"`
+	OrderID      string            `json:"stop_order_id"`
+	UserID       int               `json:"user_id"`
+	Symbol       string            `json:"symbol"`
+	Side         Side              `json:"side"`
+	OrderType    OrderType         `json:"order_type"`
+	Price        transport.Decimal `json:"price"`
+	Qty          transport.Decimal `json:"qty"`
+	TimeInForce  TimeInForce       `json:"time_in_force"`
+	OrderStatus  OrderStatus       `json:"order_status"`
+	TriggerPrice transport.Decimal `json:"trigger_price"`
+	OrderLinkID  string            `json:"order_link_id"`
+	CreatedTime  transport.Time    `json:"created_time"`
+	UpdatedTime  transport.Time    `json:"updated_time"`
+	BasePrice    transport.Decimal `json:"base_price"`
+	TriggerBy    TriggerPrice      `json:"trigger_by"`
+	TpTrigger    TriggerPrice      `json:"tp_trigger_by"`
+	SlTrigger    TriggerPrice      `json:"sl_trigger_by"`
+	TakeProfit   transport.Decimal `json:"take_profit"`
+	StopLoss     transport.Decimal `json:"stop_loss"`
 }
 
 func (this *Client) ConditionalOrderList(v OrderList) (ConditionalOrderListResult, error) {
@@ -95,16 +116,16 @@ func (this *Client) CancelAllConditionalOrders(symbol string) ([]string, error)
 
 // Replace Conditional Order (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-replacecond)
 type ReplaceConditionalOrder struct {
-	Symbol       string        `param:"symbol"`
-	OrderID      *string       `param:"stop_order_id"`
-	OrderLinkID  *string       `param:"order_link_id"`
-	Qty          *int          `param:"p_r_qty"`
-	Price        *string       `param:"p_r_price"`
-	TriggerPrice *string       `param:"p_r_trigger_price"`
-	TakeProfit   *float64      `param:"take_profit"`
-	StopLoss     *float64      `param:"stop_loss"`
-	TpTrigger    *TriggerPrice `param:"tp_trigger_by"`
-	SlTrigger    *TriggerPrice `param:"sl_trigger_by"`
+	Symbol       string             `param:"symbol"`
+	OrderID      *string            `param:"stop_order_id"`
+	OrderLinkID  *string            `param:"order_link_id"`
+	Qty          *transport.Decimal `param:"p_r_qty"`
+	Price        *transport.Decimal `param:"p_r_price"`
+	TriggerPrice *transport.Decimal `param:"p_r_trigger_price"`
+	TakeProfit   *transport.Decimal `param:"take_profit"`
+	StopLoss     *transport.Decimal `param:"stop_loss"`
+	TpTrigger    *TriggerPrice      `param:"tp_trigger_by"`
+	SlTrigger    *TriggerPrice      `param:"sl_trigger_by"`
 }
 
 func (this ReplaceConditionalOrder) Do(client *Client) (string, error) {
@@ -1,6 +1,12 @@
 // Position (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-position)
 package uperpetual
 
+import (
+	"context"
+
+	"github.com/ginarea/gobybit/transport"
+)
+
 // My Position (https://bybit-exchange.github.io/docs/futuresV2/inverse_futures/#t-myposition)
 type GetPositionAll struct {
 }
@@ -173,6 +179,9 @@ type SetLeverage struct {
 }
 
 func (this SetLeverage) Do(client *Client) error {
+	if err := client.checkLeverage(this.Symbol, this.BuyLeverage, this.SellLeverage); err != nil {
+		return err
+	}
 	_, err := Post[struct{}](client, "position/set-leverage", this)
 	return err
 }
@@ -220,26 +229,35 @@ func (this GetTradeRecords) Do(client *Client) (TradeRecords, error) {
 	return Get[TradeRecords](client, "trade/execution/list", this)
 }
 
+// Iterator over all trade records, page by page
+func (this GetTradeRecords) Iter(client *Client) *transport.Pager[TradeRecord] {
+	return transport.NewPager(client.Context(), transport.PageNumber(transport.Deref(this.Page), transport.Deref(this.Limit), func(ctx context.Context, page int) ([]TradeRecord, error) {
+		this.Page = &page
+		r, err := GetContext[TradeRecords](ctx, client, "trade/execution/list", this)
+		return r.Data, err
+	}))
+}
+
 type TradeRecord struct {
-	OrderID       string    `json:"order_id"`
-	OrderLinkID   string    `json:"order_link_id"`
-	Side          Side      `json:"side"`
-	Symbol        string    `json:"symbol"`
-	ExecID        string    `json:"exec_id"`
-	OrderPrice    string    `json:"order_price"`
-	OrderQty      int       `json:"order_qty"`
-	OrderType     OrderType `json:"order_type"`
-	FeeRate       string    `json:"fee_rate"`
-	ExecPrice     string    `json:"exec_price"`
-	ExecType      ExecType  `json:"exec_type"`
-	ExecQty       int       `json:"exec_qty"`
-	ExecFee       string    `json:"exec_fee"`
-	ExecValue     string    `json:"exec_value"`
-	LeavesQty     int       `json:"leaves_qty"`
-	ClosedSize    int       `json:"closed_size"`
-	LastLiquidity string    `json:"last_liquidity_ind"`
-	TradeTime     string    `json:"trade_time"`
-	TradeTimeMs   uint64    `json:"trade_time_ms"`
+	OrderID       string              `json:"order_id"`
+	OrderLinkID   string              `json:"order_link_id"`
+	Side          Side                `json:"side"`
+	Symbol        string              `json:"symbol"`
+	ExecID        string              `json:"exec_id"`
+	OrderPrice    string              `json:"order_price"`
+	OrderQty      int                 `json:"order_qty"`
+	OrderType     OrderType           `json:"order_type"`
+	FeeRate       string              `json:"fee_rate"`
+	ExecPrice     string              `json:"exec_price"`
+	ExecType      ExecType            `json:"exec_type"`
+	ExecQty       int                 `json:"exec_qty"`
+	ExecFee       string              `json:"exec_fee"`
+	ExecValue     string              `json:"exec_value"`
+	LeavesQty     int                 `json:"leaves_qty"`
+	ClosedSize    int                 `json:"closed_size"`
+	LastLiquidity string              `json:"last_liquidity_ind"`
+	TradeTime     transport.Time      `json:"trade_time"`
+	TradeTimeMs   transport.Timestamp `json:"trade_time_ms"`
 }
 
 type TradeRecords struct {
@@ -259,7 +277,7 @@ type GetExtendedTradeRecords struct {
 	StartTime *int      `param:"start_time"`
 	EndTime   *int      `param:"end_time"`
 	ExecType  *ExecType `json:"exec_type"`
-	pageToken *string   `param:"page_token"`
+	PageToken *string   `param:"page_token"`
 	Limit     *int      `param:"limit"`
 }
 
@@ -267,6 +285,17 @@ func (this GetExtendedTradeRecords) Do(client *Client) (ExtendedTradeRecords, er
 	return Get[ExtendedTradeRecords](client, "trade/execution/history-list", this)
 }
 
+// Iterator over all trade records, following the page token
+func (this GetExtendedTradeRecords) Iter(client *Client) *transport.Pager[TradeRecord] {
+	return transport.NewPager(client.Context(), transport.Cursor(transport.Deref(this.PageToken), func(ctx context.Context, token string) ([]TradeRecord, string, error) {
+		if token != "" {
+			this.PageToken = &token
+		}
+		r, err := GetContext[ExtendedTradeRecords](ctx, client, "trade/execution/history-list", this)
+		return r.Data, r.PageToken, err
+	}))
+}
+
 type ExtendedTradeRecords struct {
 	PageToken string        `json:"page_token"`
 	Data      []TradeRecord `json:"data"`
@@ -290,25 +319,34 @@ func (this ClosedProfitLoss) Do(client *Client) (ClosedProfitLossResult, error)
 	return Get[ClosedProfitLossResult](client, "trade/closed-pnl/list", this)
 }
 
+// Iterator over all closed positions, page by page
+func (this ClosedProfitLoss) Iter(client *Client) *transport.Pager[ClosedData] {
+	return transport.NewPager(client.Context(), transport.PageNumber(transport.Deref(this.Page), transport.Deref(this.Limit), func(ctx context.Context, page int) ([]ClosedData, error) {
+		this.Page = &page
+		r, err := GetContext[ClosedProfitLossResult](ctx, client, "trade/closed-pnl/list", this)
+		return r.Data, err
+	}))
+}
+
 type ClosedData struct {
-	ID            int       `json:"id"`
-	UserID        int       `json:"user_id"`
-	Symbol        string    `json:"symbol"`
-	OrderID       string    `json:"order_id"`
-	Side          Side      `json:"side"`
-	Qty           float64   `json:"qty"`
-	OrderPrice    float64   `json:"order_price"`
-	OrderType     OrderType `json:"order_type"`
-	ExecType      ExecType  `json:"exec_type"`
-	ClosedSize    float64   `json:"closed_size"`
-	CumEntryValue float64   `json:"cum_entry_value"`
-	AvgEntryPrice float64   `json:"avg_entry_price"`
-	CumExitValue  float64   `json:"cum_exit_value"`
-	AvgExitPrice  float64   `json:"avg_exit_price"`
-	ClosedPnl     float64   `json:"closed_pnl"`
-	FillCount     int       `json:"fill_count"`
-	Leverage      int       `json:"leverage"`
-	CreatedAt     uint64    `json:"created_at"`
+	ID            int                 `json:"id"`
+	UserID        int                 `json:"user_id"`
+	Symbol        string              `json:"symbol"`
+	OrderID       string              `json:"order_id"`
+	Side          Side                `json:"side"`
+	Qty           float64             `json:"qty"`
+	OrderPrice    float64             `json:"order_price"`
+	OrderType     OrderType           `json:"order_type"`
+	ExecType      ExecType            `json:"exec_type"`
+	ClosedSize    float64             `json:"closed_size"`
+	CumEntryValue float64             `json:"cum_entry_value"`
+	AvgEntryPrice float64             `json:"avg_entry_price"`
+	CumExitValue  float64             `json:"cum_exit_value"`
+	AvgExitPrice  float64             `json:"avg_exit_price"`
+	ClosedPnl     float64             `json:"closed_pnl"`
+	FillCount     int                 `json:"fill_count"`
+	Leverage      int                 `json:"leverage"`
+	CreatedAt     transport.Timestamp `json:"created_at"`
 }
 
 type ClosedProfitLossResult struct {
@@ -1,6 +1,8 @@
 // Risk Limit (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-risklimit)
 package uperpetual
 
+import "github.com/ginarea/gobybit/transport"
+
 // Get Risk Limit (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-getrisklimit)
 type GetRiskLimit struct {
 	Symbol *string `param:"symbol"`
@@ -11,16 +13,16 @@ func (this GetRiskLimit) Do(client *Client) ([]RiskLimitItem, error) {
 }
 
 type RiskLimitItem struct {
-	ID             int      `json:"id"`
-	Symbol         string   `json:"symbol"`
-	Limit          int      `json:"limit"`
-	MaintainMargin float64  `json:"maintain_margin"`
-	StartingMargin float64  `json:"starting_margin"`
-	Section        []string `json:"section"`
-	IsLowestRisk   int      `json:"is_lowest_risk"`
-	CreatedAt      string   `json:"created_at"`
-	UpdatedAt      string   `json:"updated_at"`
-	MaxLeverage    float64  `json:"max_leverage"`
+	ID             int            `json:"id"`
+	Symbol         string         `json:"symbol"`
+	Limit          int            `json:"limit"`
+	MaintainMargin float64        `json:"maintain_margin"`
+	StartingMargin float64        `json:"starting_margin"`
+	Section        []string       `json:"section"`
+	IsLowestRisk   int            `json:"is_lowest_risk"`
+	CreatedAt      transport.Time `json:"created_at"`
+	UpdatedAt      transport.Time `json:"updated_at"`
+	MaxLeverage    float64        `json:"max_leverage"`
 }
 
 func (this *Client) GetRiskLimit(symbol *string) ([]RiskLimitItem, error) {
@@ -2,6 +2,7 @@
 package uperpetual
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ginarea/gobybit/iperpetual"
@@ -10,47 +11,109 @@ import (
 
 // USDT Perpetual HTTP client
 type Client struct {
-	c *transport.Client
+	c           *transport.Client
+	ctx         context.Context
+	instruments *transport.Instruments
 }
 
 func NewClient(client *transport.Client) *Client {
 	return &Client{c: client}
 }
 
+// Copy of the client which binds every request (including Do methods) to ctx
+func (this *Client) WithContext(ctx context.Context) *Client {
+	return &Client{c: this.c, ctx: ctx, instruments: this.instruments}
+}
+
+// Copy of the client which rounds and validates orders by symbol rules before sending
+func (this *Client) WithInstruments(instruments *transport.Instruments) *Client {
+	return &Client{c: this.c, ctx: this.ctx, instruments: instruments}
+}
+
+func (this *Client) Instruments() *transport.Instruments {
+	return this.instruments
+}
+
+func (this *Client) Context() context.Context {
+	if this.ctx == nil {
+		return context.Background()
+	}
+	return this.ctx
+}
+
 func (this *Client) Transport() *transport.Client {
 	return this.c
 }
 
 func (this *Client) GetPublic(path string, param any, ret any) error {
-	return forwardError(this.c.Get(this.urlPublic(path), param, ret))
+	return this.GetPublicContext(this.Context(), path, param, ret)
 }
 
 func (this *Client) Get(path string, param any, ret any) error {
-	return forwardError(this.c.Get(this.urlPrivate(path), param, ret))
+	return this.GetContext(this.Context(), path, param, ret)
 }
 
 func (this *Client) Post(path string, param any, ret any) error {
-	return forwardError(this.c.Post(this.urlPrivate(path), param, ret))
+	return this.PostContext(this.Context(), path, param, ret)
+}
+
+func (this *Client) GetPublicContext(ctx context.Context, path string, param any, ret any) error {
+	return forwardError(this.c.GetContext(ctx, this.urlPublic(path), param, ret))
+}
+
+func (this *Client) GetContext(ctx context.Context, path string, param any, ret any) error {
+	return forwardError(this.c.GetContext(ctx, this.urlPrivate(path), param, ret))
+}
+
+func (this *Client) PostContext(ctx context.Context, path string, param any, ret any) error {
+	return forwardError(this.c.PostContext(ctx, this.urlPrivate(path), param, ret))
 }
 
 func GetPublic[T any](c *Client, path string, param any) (T, error) {
-	resp := &Response[T]{}
-	err := c.GetPublic(path, param, resp)
-	return resp.Result, err
+	return GetPublicContext[T](c.Context(), c, path, param)
 }
 
 func Get[T any](c *Client, path string, param any) (T, error) {
-	resp := &Response[T]{}
-	err := c.Get(path, param, resp)
-	return resp.Result, err
+	return GetContext[T](c.Context(), c, path, param)
 }
 
 func Post[T any](c *Client, path string, param any) (T, error) {
-	resp := &Response[T]{}
-	err := c.Post(path, param, resp)
+	return PostContext[T](c.Context(), c, path, param)
+}
+
+func GetPublicContext[T any](ctx context.Context, c *Client, path string, param any) (T, error) {
+	resp, err := GetPublicResponse[T](ctx, c, path, param)
+	return resp.Result, err
+}
+
+func GetContext[T any](ctx context.Context, c *Client, path string, param any) (T, error) {
+	resp, err := GetResponse[T](ctx, c, path, param)
+	return resp.Result, err
+}
+
+func PostContext[T any](ctx context.Context, c *Client, path string, param any) (T, error) {
+	resp, err := PostResponse[T](ctx, c, path, param)
 	return resp.Result, err
 }
 
+func GetPublicResponse[T any](ctx context.Context, c *Client, path string, param any) (*Response[T], error) {
+	resp := &Response[T]{}
+	err := c.GetPublicContext(ctx, path, param, resp)
+	return resp, err
+}
+
+func GetResponse[T any](ctx context.Context, c *Client, path string, param any) (*Response[T], error) {
+	resp := &Response[T]{}
+	err := c.GetContext(ctx, path, param, resp)
+	return resp, err
+}
+
+func PostResponse[T any](ctx context.Context, c *Client, path string, param any) (*Response[T], error) {
+	resp := &Response[T]{}
+	err := c.PostContext(ctx, path, param, resp)
+	return resp, err
+}
+
 func (this *Client) url(access, path string) string {
 	return fmt.Sprintf("%s/linear/%s", access, path)
 }
@@ -64,5 +127,5 @@ func (this *Client) urlPrivate(path string) string {
 }
 
 func (this *Client) iperpetual() *iperpetual.Client {
-	return iperpetual.NewClient(this.c)
+	return iperpetual.NewClient(this.c).WithContext(this.Context())
 }
@@ -10,10 +10,98 @@ type Error struct {
 	transport.Err
 }
 
+// Error codes (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-errors)
+var errorCodes = transport.CodeMap{
+	130001: transport.ErrInvalidParam,        // Invalid parameter
+	130002: transport.ErrInvalidParam,        // Invalid symbol
+	130003: transport.ErrInvalidParam,        // Invalid side
+	130004: transport.ErrInvalidParam,        // Order qty exceeds the maximum allowed
+	130005: transport.ErrInvalidParam,        // Order price exceeds the maximum allowed
+	130006: transport.ErrInvalidParam,        // Order qty is below the minimum allowed
+	130007: transport.ErrInvalidParam,        // Order price is out of permissible range
+	130008: transport.ErrInvalidParam,        // Invalid order_type
+	130009: transport.ErrInvalidParam,        // Order qty is not a multiple of the qty step
+	130010: transport.ErrOrderNotFound,       // Order not exists or too late to replace
+	130011: transport.ErrInvalidParam,        // Operation not allowed as position is undergoing liquidation
+	130012: transport.ErrInvalidParam,        // Operation not allowed as position is undergoing ADL
+	130013: transport.ErrInvalidParam,        // Position is in liq or adl status
+	130014: transport.ErrInvalidParam,        // Invalid closing order, qty should not greater than size
+	130015: transport.ErrInvalidParam,        // Invalid closing order, side should be opposite
+	130016: transport.ErrInvalidParam,        // TS and SL must be cancelled first while closing position
+	130017: transport.ErrInvalidParam,        // Estimated fill price cannot be lower than current Buy liq_price
+	130018: transport.ErrInvalidParam,        // Estimated fill price cannot be higher than current Sell liq_price
+	130019: transport.ErrInvalidParam,        // Cannot attach TP/SL params for non-zero position when placing non-opening position order
+	130020: transport.ErrInvalidParam,        // Position already has TP/SL params
+	130021: transport.ErrInsufficientBalance, // Order cost not available
+	130022: transport.ErrInsufficientBalance, // Estimated buy liq_price cannot be higher than current mark_price
+	130023: transport.ErrInsufficientBalance, // Estimated sell liq_price cannot be lower than current mark_price
+	130024: transport.ErrInvalidParam,        // Cannot set TP/SL/TS for zero-position
+	130025: transport.ErrInvalidParam,        // Trigger price is below 10% of base price
+	130026: transport.ErrInvalidParam,        // Trigger price is too high
+	130027: transport.ErrInvalidParam,        // Take profit of Buy position should be higher than base_price
+	130028: transport.ErrInvalidParam,        // Stop loss of Sell position should be between liq_price and base_price
+	130029: transport.ErrInvalidParam,        // Stop loss of Buy position should be between liq_price and base_price
+	130030: transport.ErrInvalidParam,        // Take profit of Sell position should be lower than base_price
+	130031: transport.ErrInsufficientBalance, // Insufficient available balance for order cost
+	130032: transport.ErrOrderNotFound,       // Invalid order_status, cannot cancel or execute trigger
+	130033: transport.ErrInvalidParam,        // Number of stop orders exceeds maximum limit allowed
+	130034: transport.ErrOrderNotFound,       // Order not exists or too late to cancel
+	130035: transport.ErrRateLimit,           // Too freq to cancel, try it later
+	130036: transport.ErrInvalidParam,        // Expected position value after order execution exceeds the current risk limit
+	130037: transport.ErrOrderNotFound,       // Order already cancelled
+	130040: transport.ErrInsufficientBalance, // Position will be liquidated
+	130041: transport.ErrInsufficientBalance, // Available balance less than 0
+	130049: transport.ErrInsufficientBalance, // Available balance not enough
+	130050: transport.ErrInsufficientBalance, // Any adjustments made will trigger immediate liquidation
+	130051: transport.ErrInvalidParam,        // Cannot set leverage, due to risk limit
+	130052: transport.ErrInvalidParam,        // Invalid trigger price
+	130053: transport.ErrInvalidParam,        // Cannot set leverage, above the upper limit
+	130054: transport.ErrInvalidParam,        // Position margin is invalid
+	130055: transport.ErrInsufficientBalance, // Position margin is insufficient
+	130056: transport.ErrPositionMode,        // Position is in cross margin mode
+	130057: transport.ErrInvalidParam,        // Position size is 0
+	130058: transport.ErrInvalidParam,        // Cannot set margin less than minimum margin
+	130059: transport.ErrInvalidParam,        // Position is in liquidation
+	130060: transport.ErrInvalidParam,        // auto_add_margin not changed
+	130061: transport.ErrInvalidParam,        // Fee rate not changed
+	130062: transport.ErrInvalidParam,        // Cannot set margin less than minimum margin
+	130063: transport.ErrInvalidParam,        // Reduce-only rule not satisfied
+	130064: transport.ErrInsufficientBalance, // Withdrawal exceeds available margin
+	130074: transport.ErrInvalidParam,        // Expect rising, but trigger price is not above the current price
+	130075: transport.ErrInvalidParam,        // Expect falling, but trigger price is not below the current price
+	130076: transport.ErrInvalidParam,        // Replace params invalid
+	130077: transport.ErrInvalidParam,        // Trigger price deviates too much from the market price
+	130079: transport.ErrInvalidParam,        // Invalid side
+	130101: transport.ErrServer,              // Unknown request for create order
+	130102: transport.ErrServer,              // Unknown request for cancel order
+	130103: transport.ErrServer,              // Unknown request for cancel all
+	130104: transport.ErrServer,              // Unknown request for liquidation execution
+	130105: transport.ErrServer,              // Unknown request for pre-create order
+	130106: transport.ErrServer,              // Unknown request for query order
+	130107: transport.ErrServer,              // Unknown request for set margin
+	130108: transport.ErrServer,              // Unknown request for query position
+	130109: transport.ErrServer,              // Unknown request for set leverage
+	130110: transport.ErrServer,              // Unknown request for set trading stop
+	130111: transport.ErrOrderNotFound,       // No order found
+	130118: transport.ErrInvalidParam,        // Order qty exceeds the risk limit
+	130119: transport.ErrInvalidParam,        // Order price is out of the liquidation range
+	130120: transport.ErrInvalidParam,        // Leverage not modified
+	130121: transport.ErrInvalidParam,        // Risk limit not modified
+	130122: transport.ErrInvalidParam,        // Stop order price is invalid
+	130125: transport.ErrInvalidParam,        // Current position is zero, cannot fix reduce-only order qty
+	130126: transport.ErrPositionMode,        // Cross/isolated margin mode not modified
+	130127: transport.ErrPositionMode,        // Cannot switch margin mode with open orders or positions
+	130128: transport.ErrInvalidParam,        // TP/SL mode not modified
+	130129: transport.ErrInvalidParam,        // TP/SL order qty exceeds the position size
+	130130: transport.ErrInvalidParam,        // Number of TP/SL orders exceeds the limit
+	130149: transport.ErrPositionMode,        // position_idx does not match position mode
+	130150: transport.ErrPositionMode,        // Position mode not modified
+}
+
 func forwardError(err error) error {
 	var terr *transport.Error
 	if errors.As(err, &terr) {
-		return &Error{Err: terr.Err}
+		return &Error{Err: terr.Err.Classify(errorCodes)}
 	}
 	return err
 }
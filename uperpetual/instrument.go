@@ -0,0 +1,30 @@
+package uperpetual
+
+import (
+	"github.com/ginarea/gobybit/iperpetual"
+	"github.com/ginarea/gobybit/transport"
+)
+
+// Registry of USDT perpetual symbols loaded by QuerySymbol of the derivatives API
+func NewInstruments(client *Client) *transport.Instruments {
+	return iperpetual.NewInstruments(client.iperpetual())
+}
+
+func (this *Client) prepareOrder(symbol string, qty *transport.Decimal, prices ...*transport.Decimal) error {
+	if this.instruments == nil {
+		return nil
+	}
+	return this.instruments.PrepareOrder(symbol, qty, prices...)
+}
+
+func (this *Client) checkLeverage(symbol string, leverage ...int) error {
+	if this.instruments == nil {
+		return nil
+	}
+	for _, v := range leverage {
+		if err := this.instruments.CheckLeverage(symbol, transport.DecimalFromInt(int64(v))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 // Market Data Endpoints (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-marketdata)
 package uperpetual
 
+import "github.com/ginarea/gobybit/transport"
+
 // Query Symbol (https://bybit-exchange.github.io/docs/futuresV2/linear/#t-querysymbol)
 // using iperpetual
 
@@ -25,18 +27,18 @@ func (this QueryKline) Do(client *Client) ([]KlineItem, error) {
 }
 
 type KlineItem struct {
-	ID       int           `json:"id"`
-	Symbol   string        `json:"symbol"`
-	Period   KlineInterval `json:"period"`
-	StartAt  uint64        `json:"start_at"`
-	Volume   float64       `json:"volume"`
-	Open     float64        `json:"open"`
-	High     float64        `json:"high"`
-	Low      float64        `json:"low"`
-	Close    float64        `json:"close"`
-	Interval KlineInterval `json:"interval"`
-	OpenTime uint64        `json:"open_time"`
-	Turnover float64        `json:"turnover"`
+	ID       int                 `json:"id"`
+	Symbol   string              `json:"symbol"`
+	Period   KlineInterval       `json:"period"`
+	StartAt  transport.Timestamp `json:"start_at"`
+	Volume   transport.Decimal   `json:"volume"`
+	Open     transport.Decimal   `json:"open"`
+	High     transport.Decimal   `json:"high"`
+	Low      transport.Decimal   `json:"low"`
+	Close    transport.Decimal   `json:"close"`
+	Interval KlineInterval       `json:"interval"`
+	OpenTime transport.Timestamp `json:"open_time"`
+	Turnover transport.Decimal   `json:"turnover"`
 }
 
 func (this *Client) QueryKline(v QueryKline) ([]KlineItem, error) {
@@ -60,14 +62,14 @@ func (this PublicTradingRecords) Do(client *Client) ([]PublicTradingRecord, erro
 }
 
 type PublicTradingRecord struct {
-	ID           string  `json:"id"`
-	Symbol       string  `json:"symbol"`
-	Price        float64 `json:"price"`
-	Qty          float64 `json:"qty"`
-	Side         Side    `json:"side"`
-	Time         string  `json:"time"`
-	TradeTime    uint64  `json:"trade_time_ms"`
-	IsBlockTrade bool    `json:"is_block_trade"`
+	ID           string              `json:"id"`
+	Symbol       string              `json:"symbol"`
+	Price        float64             `json:"price"`
+	Qty          float64             `json:"qty"`
+	Side         Side                `json:"side"`
+	Time         transport.Time      `json:"time"`
+	TradeTime    transport.Timestamp `json:"trade_time_ms"`
+	IsBlockTrade bool                `json:"is_block_trade"`
 }
 
 func (this *Client) PublicTradingRecords(v PublicTradingRecords) ([]PublicTradingRecord, error) {
@@ -89,9 +91,9 @@ func (this GetLastFundingRate) Do(client *Client) (LastFundingRate, error) {
 }
 
 type LastFundingRate struct {
-	Symbol      string  `json:"symbol"`
-	FundingRate float64 `json:"funding_rate"`
-	Timestamp   string  `json:"funding_rate_timestamp"`
+	Symbol      string         `json:"symbol"`
+	FundingRate float64        `json:"funding_rate"`
+	Timestamp   transport.Time `json:"funding_rate_timestamp"`
 }
 
 func (this *Client) GetLastFundingRate(symbol string) (LastFundingRate, error) {
@@ -106,13 +108,13 @@ func (this QueryKline) DoMark(client *Client) ([]MarkKlineItem, error) {
 }
 
 type MarkKlineItem struct {
-	Symbol   string        `json:"symbol"`
-	Interval KlineInterval `json:"period"`
-	OpenTime uint64        `json:"start_at"`
-	Open     int           `json:"open"`
-	High     int           `json:"high"`
-	Low      int           `json:"low"`
-	Close    int           `json:"close"`
+	Symbol   string              `json:"symbol"`
+	Interval KlineInterval       `json:"period"`
+	OpenTime transport.Timestamp `json:"start_at"`
+	Open     transport.Decimal   `json:"open"`
+	High     transport.Decimal   `json:"high"`
+	Low      transport.Decimal   `json:"low"`
+	Close    transport.Decimal   `json:"close"`
 }
 
 func (this *Client) QueryMarkKline(v QueryKline) ([]MarkKlineItem, error) {
@@ -127,13 +129,13 @@ func (this QueryKline) DoIndex(client *Client) ([]IndexKlineItem, error) {
 }
 
 type IndexKlineItem struct {
-	Symbol   string        `json:"symbol"`
-	Interval KlineInterval `json:"period"`
-	OpenTime uint64        `json:"open_time"`
-	Open     string        `json:"open"`
-	High     string        `json:"high"`
-	Low      string        `json:"low"`
-	Close    string        `json:"close"`
+	Symbol   string              `json:"symbol"`
+	Interval KlineInterval       `json:"period"`
+	OpenTime transport.Timestamp `json:"open_time"`
+	Open     transport.Decimal   `json:"open"`
+	High     transport.Decimal   `json:"high"`
+	Low      transport.Decimal   `json:"low"`
+	Close    transport.Decimal   `json:"close"`
 }
 
 func (this *Client) QueryIndexKline(v QueryKline) ([]IndexKlineItem, error) {
@@ -1,19 +1,24 @@
 package uperpetual
 
 import (
-	"strings"
+	"errors"
+	"sync/atomic"
 
 	"github.com/ginarea/gobybit/transport"
-	"github.com/msw-x/moon"
 	"github.com/msw-x/moon/ufmt"
 	"github.com/msw-x/moon/ulog"
 )
 
 type WsClient struct {
-	log         *ulog.Log
-	ws          *transport.WsClient
-	onConnected func()
-	onAuth      func(bool)
+	log            *ulog.Log
+	ws             *transport.WsClient
+	section        *WsSection
+	authorize      func()
+	ready          int32
+	onConnected    func()
+	onDisconnected func()
+	onAuth         func(bool)
+	onReady        func(bool)
 }
 
 func NewWsClient(name string, url string) *WsClient {
@@ -38,6 +43,11 @@ func (this *WsClient) WithLog(log *ulog.Log) *WsClient {
 	return this
 }
 
+func (this *WsClient) WithUrl(url string) *WsClient {
+	this.ws.WithUrl(url)
+	return this
+}
+
 func (this *WsClient) WithProxy(proxy string) *WsClient {
 	this.Conf().SetProxy(proxy)
 	return this
@@ -47,24 +57,71 @@ func (this *WsClient) Connected() bool {
 	return this.ws.Connected()
 }
 
+// Ready to subscribe: connected and authorized (private stream)
+func (this *WsClient) Ready() bool {
+	return atomic.LoadInt32(&this.ready) == 1
+}
+
+func (this *WsClient) setReady(ready bool) {
+	this.storeReady(ready)
+	this.notifyReady(ready)
+}
+
+func (this *WsClient) storeReady(ready bool) {
+	var v int32
+	if ready {
+		v = 1
+	}
+	atomic.StoreInt32(&this.ready, v)
+}
+
+func (this *WsClient) notifyReady(ready bool) {
+	if this.onReady != nil {
+		this.onReady(ready)
+	}
+}
+
 func (this *WsClient) Run() {
 	this.log.Debug("run")
+	this.ws.SetOnConnected(func() {
+		if this.onConnected != nil {
+			this.onConnected()
+		}
+		if this.authorize == nil {
+			this.subscribeAll()
+		} else {
+			this.log.Info("auth")
+			this.authorize()
+		}
+	})
+	this.ws.SetOnDisconnected(func() {
+		this.setReady(false)
+		if this.onDisconnected != nil {
+			this.onDisconnected()
+		}
+	})
+	this.ws.SetOnResubscribe(this.resubscribe)
 	this.ws.SetOnMessage(this.processMessage)
 	this.ws.Run()
 }
 
 func (this *WsClient) SetOnConnected(onConnected func()) {
-	this.ws.SetOnConnected(onConnected)
+	this.onConnected = onConnected
 }
 
-func (this *WsClient) SetOnDisconnected(onConnected func()) {
-	this.ws.SetOnDisconnected(onConnected)
+func (this *WsClient) SetOnDisconnected(onDisconnected func()) {
+	this.onDisconnected = onDisconnected
 }
 
 func (this *WsClient) SetOnAuth(onAuth func(bool)) {
 	this.onAuth = onAuth
 }
 
+// Decode, protocol and unknown topic errors with the raw frame
+func (this *WsClient) SetOnError(onError func(*transport.WsError)) {
+	this.ws.SetOnError(onError)
+}
+
 func (this *WsClient) Send(cmd any) bool {
 	return this.ws.Send(cmd)
 }
@@ -94,13 +151,41 @@ func (this *Subscription) Request(operation string) Request {
 }
 
 func (this *WsClient) Subscribe(s Subscription) bool {
-	this.log.Infof("subscribe: topic[%s]", s.Topic)
-	return this.ws.Send(s.Request("subscribe"))
+	return this.subscribe(s.String())
 }
 
 func (this *WsClient) Unsubscribe(s Subscription) bool {
-	this.log.Infof("unsubscribe: topic[%s]", s.Topic)
-	return this.ws.Send(s.Request("unsubscribe"))
+	return this.unsubscribe(s.String())
+}
+
+func (this *WsClient) subscribe(topic string) bool {
+	this.log.Infof("subscribe: topic[%s]", topic)
+	return this.ws.Send(Request{
+		Operation: "subscribe",
+		Args:      []string{topic},
+	})
+}
+
+func (this *WsClient) unsubscribe(topic string) bool {
+	this.log.Infof("unsubscribe: topic[%s]", topic)
+	return this.ws.Send(Request{
+		Operation: "unsubscribe",
+		Args:      []string{topic},
+	})
+}
+
+func (this *WsClient) resubscribe(topic string) {
+	this.unsubscribe(topic)
+	this.subscribe(topic)
+}
+
+// Sends the stored subscriptions and becomes ready
+func (this *WsClient) subscribeAll() {
+	if this.section != nil {
+		this.section.subscribeAll()
+	} else {
+		this.setReady(true)
+	}
 }
 
 type Request struct {
@@ -119,86 +204,81 @@ func (this *WsClient) processMessage(name string, msg []byte) {
 	this.log.Debug("name:", name)
 	switch name {
 	case "success":
-		v := transport.JsonUnmarshal[Responce](msg)
-		this.processResponce(v)
+		v, err := transport.JsonUnmarshal[Responce](msg)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
+		this.processResponce(v, msg)
 	case "topic":
-		v := transport.JsonUnmarshal[struct {
+		v, err := transport.JsonUnmarshal[struct {
 			Name string `json:"topic"`
 			Type string `json:"type"`
 		}](msg)
-		s := strings.Split(v.Name, ".")
-		name := s[0]
-		this.processTopic(TopicName(name), v.Type == "delta", msg)
+		if err != nil {
+			this.reportError(transport.ErrDecode, name, msg, err)
+			return
+		}
+		this.processTopic(TopicMessage{
+			Topic: v.Name,
+			Delta: v.Type == "delta",
+			Bin:   msg,
+		})
 	default:
-		moon.Panic("unknown message:", name)
+		this.reportError(transport.ErrWsProtocol, name, msg, errors.New("unknown message"))
 	}
 }
 
-func (this *WsClient) processResponce(r Responce) {
-	if !r.Success {
-		this.log.Error(r.RetMsg)
-		return
-	}
+func (this *WsClient) processResponce(r Responce, msg []byte) {
+	// A failed request carries the error text in ret_msg
 	name := r.RetMsg
-	if name == "" {
+	if name == "" || !r.Success {
 		name = r.Request.Operation
 	}
+	if !r.Success && name != "auth" {
+		this.reportError(transport.ErrWsProtocol, name, msg, errors.New(r.RetMsg))
+		return
+	}
 	this.log.Debug("response:", name, "success:", r.Success)
 	switch name {
 	case "pong":
 	case "auth":
+		this.log.Info("auth:", ufmt.SuccessFailure(r.Success))
+		this.ws.NotifyAuth(r.Success)
 		if this.onAuth != nil {
 			this.onAuth(r.Success)
 		}
+		if !r.Success {
+			this.reportError(transport.ErrWsProtocol, name, msg, errors.New(r.RetMsg))
+			return
+		}
+		this.subscribeAll()
 	case "subscribe":
 	case "unsubscribe":
 	default:
-		moon.Panic("unknown response:", name)
+		this.reportError(transport.ErrWsProtocol, name, msg, errors.New("unknown response"))
 	}
 }
 
-func (this *WsClient) processTopic(topic TopicName, delta bool, msg []byte) {
-	type OrderBookResult struct {
-		OrderBook []OrderBookSnapshot `json:"order_book"`
+func (this *WsClient) processTopic(m TopicMessage) {
+	ok := false
+	var err error
+	if this.section != nil {
+		ok, err = this.section.processTopic(m)
 	}
-	switch topic {
-	// public
-	case TopicOrderBook25:
-		if delta {
-			transport.JsonUnmarshal[Topic[OrderBookDelta]](msg)
-		} else {
-			transport.JsonUnmarshal[Topic[OrderBookResult]](msg)
-		}
-	case TopicOrderBook200:
-		if delta {
-			transport.JsonUnmarshal[Topic[OrderBookDelta]](msg)
-		} else {
-			transport.JsonUnmarshal[Topic[OrderBookResult]](msg)
-		}
-	case TopicTrade:
-		transport.JsonUnmarshal[Topic[[]TradeSnapshot]](msg)
-	case TopicInstrument:
-		if delta {
-			transport.JsonUnmarshal[Topic[InstrumentDelta]](msg)
-		} else {
-			transport.JsonUnmarshal[Topic[InstrumentSnapshot]](msg)
-		}
-	case TopicKline:
-		transport.JsonUnmarshal[Topic[[]KlineSnapshot]](msg)
-	case TopicLiquidation:
-		transport.JsonUnmarshal[Topic[LiquidationSnapshot]](msg)
-	//private
-	case TopicPosition:
-		transport.JsonUnmarshal[Topic[[]PositionSnapshot]](msg)
-	case TopicExecution:
-		transport.JsonUnmarshal[Topic[[]ExecutionSnapshot]](msg)
-	case TopicOrder:
-		transport.JsonUnmarshal[Topic[[]OrderSnapshot]](msg)
-	case TopicStopOrder:
-		transport.JsonUnmarshal[Topic[[]StopOrderSnapshot]](msg)
-	case TopicWallet:
-		transport.JsonUnmarshal[Topic[[]WalletSnapshot]](msg)
-	default:
-		moon.Panic("unknown topic:", topic)
+	if err != nil {
+		this.reportError(transport.ErrDecode, m.Topic, m.Bin, err)
+	} else if !ok {
+		this.reportError(transport.ErrUnknownTopic, m.Topic, m.Bin, nil)
 	}
 }
+
+func (this *WsClient) reportError(kind error, name string, msg []byte, err error) {
+	this.ws.ReportError(transport.NewWsError(kind, name, msg, err))
+}
+
+type TopicMessage struct {
+	Topic string
+	Delta bool
+	Bin   []byte
+}
@@ -0,0 +1,141 @@
+package uperpetual
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type Delta struct {
+	Delete []any `json:"delete"`
+	Update []any `json:"update"`
+	Insert []any `json:"insert"`
+}
+
+func (this *Delta) HasData() bool {
+	return len(this.Delete) > 0 || len(this.Update) > 0 || len(this.Insert) > 0
+}
+
+// Id of delta item; ids come both as strings and numbers
+func wsDeltaID(m map[string]any) (string, bool) {
+	switch v := m["id"].(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// Id of snapshot item (field ID of any integer or string type)
+func wsShotID(item reflect.Value) string {
+	f := item.FieldByName("ID")
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(f.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(f.Uint(), 10)
+	}
+	return ""
+}
+
+func wsDeltaIDs(slice []any) map[string]bool {
+	ids := make(map[string]bool)
+	for _, v := range slice {
+		if m, ok := v.(map[string]any); ok {
+			if id, ok := wsDeltaID(m); ok {
+				ids[id] = true
+			}
+		}
+	}
+	return ids
+}
+
+// Set delta value by json name in struct; values are decoded as json, so numbers
+// sent as strings (and vice versa) and fields with custom decoding are accepted
+func wsDeltaSetValue(vs reflect.Value, name string, v any) {
+	for i := 0; i < vs.NumField(); i++ {
+		label, _, _ := strings.Cut(vs.Type().Field(i).Tag.Get("json"), ",")
+		if label != name {
+			continue
+		}
+		f := vs.Field(i)
+		if !f.CanSet() {
+			return
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return
+		}
+		p := reflect.New(f.Type())
+		if err = json.Unmarshal(b, p.Interface()); err != nil {
+			if s, ok := v.(string); ok {
+				err = json.Unmarshal([]byte(s), p.Interface())
+			} else if f.Kind() == reflect.String {
+				err = json.Unmarshal([]byte(strconv.Quote(string(b))), p.Interface())
+			}
+		}
+		if err == nil {
+			f.Set(p.Elem())
+		}
+		return
+	}
+}
+
+func wsDeltaSetValues(item reflect.Value, m map[string]any) {
+	for name, value := range m {
+		wsDeltaSetValue(item, name, value)
+	}
+}
+
+// Apply delta: items of a slice are matched by id, a struct is updated in place
+func WsDeltaApply[T any](v *T, delta Delta) {
+	rv := reflect.ValueOf(v).Elem()
+	if rv.Kind() != reflect.Slice {
+		WsDeltaUpdate(v, delta)
+		return
+	}
+	if len(delta.Delete) > 0 {
+		ids := wsDeltaIDs(delta.Delete)
+		slice := reflect.MakeSlice(rv.Type(), 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if item := rv.Index(i); !ids[wsShotID(item)] {
+				slice = reflect.Append(slice, item)
+			}
+		}
+		rv.Set(slice)
+	}
+	for _, k := range delta.Insert {
+		if m, ok := k.(map[string]any); ok {
+			item := reflect.New(rv.Type().Elem()).Elem()
+			wsDeltaSetValues(item, m)
+			rv.Set(reflect.Append(rv, item))
+		}
+	}
+	for _, k := range delta.Update {
+		if m, ok := k.(map[string]any); ok {
+			id, ok := wsDeltaID(m)
+			if !ok {
+				continue
+			}
+			for i := 0; i < rv.Len(); i++ {
+				if item := rv.Index(i); wsShotID(item) == id {
+					wsDeltaSetValues(item, m)
+					break
+				}
+			}
+		}
+	}
+}
+
+// Apply delta to struct
+func WsDeltaUpdate[T any](s *T, delta Delta) {
+	for _, k := range delta.Update {
+		if m, ok := k.(map[string]any); ok {
+			wsDeltaSetValues(reflect.ValueOf(s).Elem(), m)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package uperpetual
+
+import "github.com/ginarea/gobybit/transport"
+
+type WsExecutor[T any] struct {
+	section      wsSection
+	subscription Subscription
+}
+
+func NewWsExecutor[T any](section wsSection, subscription Subscription) *WsExecutor[T] {
+	e := &WsExecutor[T]{}
+	e.Init(section, subscription)
+	return e
+}
+
+func (this *WsExecutor[T]) Init(section wsSection, subscription Subscription) {
+	this.section = section
+	this.subscription = subscription
+}
+
+func (this *WsExecutor[T]) Subscribe(onShot func(T)) {
+	this.section.subscribe(this.subscription, func(m []byte, delta bool) error {
+		return WsFunc(m, onShot)
+	})
+}
+
+func (this *WsExecutor[T]) Unsubscribe() {
+	this.section.unsubscribe(this.subscription)
+}
+
+func (this *WsExecutor[T]) Instant() *WsInstant[T] {
+	return NewWsInstant[T](this)
+}
+
+// Values through a channel of size buffered values instead of a callback
+func (this *WsExecutor[T]) Chan(size int, overflow transport.WsOverflow) *transport.WsChan[T] {
+	c := transport.NewWsChan[T](size, overflow, this.Unsubscribe)
+	this.Subscribe(c.Push)
+	return c
+}
+
+type WsDeltaExecutor[T any] struct {
+	WsExecutor[T]
+}
+
+func NewWsDeltaExecutor[T any](section wsSection, subscription Subscription) *WsDeltaExecutor[T] {
+	e := &WsDeltaExecutor[T]{}
+	e.Init(section, subscription)
+	return e
+}
+
+func (this *WsDeltaExecutor[T]) SubscribeWithDelta(onShot func(T), onDelta func(Delta)) {
+	this.section.subscribe(this.subscription, func(m []byte, delta bool) error {
+		return WsFuncDelta(m, onShot, delta, onDelta)
+	})
+}
+
+// Snapshot merged with every delta
+func (this *WsDeltaExecutor[T]) Subscribe(onShot func(T)) {
+	var current T
+	this.SubscribeWithDelta(func(shot T) {
+		current = shot
+		onShot(current)
+	}, func(delta Delta) {
+		if delta.HasData() {
+			WsDeltaApply(&current, delta)
+			onShot(current)
+		}
+	})
+}
+
+func (this *WsDeltaExecutor[T]) Instant() *WsInstant[T] {
+	return NewWsInstant[T](this)
+}
+
+// Merged snapshots through a channel; each value is a copy
+func (this *WsDeltaExecutor[T]) Chan(size int, overflow transport.WsOverflow) *transport.WsChan[T] {
+	c := transport.NewWsChan[T](size, overflow, this.Unsubscribe)
+	this.Subscribe(func(v T) {
+		c.Push(transport.WsClone(v))
+	})
+	return c
+}
@@ -1,10 +1,6 @@
 package uperpetual
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
 	"time"
 
 	"github.com/ginarea/gobybit/transport"
@@ -12,17 +8,24 @@ import (
 )
 
 type WsPrivate struct {
-	ws     *WsClient
+	WsSection
 	key    string
-	secret string
+	signer transport.Signer
 }
 
 func NewWsPrivate(key string, secret string) *WsPrivate {
-	return &WsPrivate{
-		ws:     NewWsClient("private", "wss://stream.bybit.com/realtime_private"),
+	c := &WsPrivate{
 		key:    key,
-		secret: secret,
+		signer: transport.NewHmacSigner(secret),
 	}
+	c.init(NewWsClient("private", transport.DefaultProfile().WsLinearPrivate))
+	c.ws.authorize = c.auth
+	return c
+}
+
+func (this *WsPrivate) WithSigner(signer transport.Signer) *WsPrivate {
+	this.signer = signer
+	return this
 }
 
 func (this *WsPrivate) Shutdown() {
@@ -38,6 +41,15 @@ func (this *WsPrivate) WithLog(log *ulog.Log) *WsPrivate {
 	return this
 }
 
+func (this *WsPrivate) WithUrl(url string) *WsPrivate {
+	this.ws.WithUrl(url)
+	return this
+}
+
+func (this *WsPrivate) WithProfile(profile transport.Profile) *WsPrivate {
+	return this.WithUrl(profile.WsLinearPrivate)
+}
+
 func (this *WsPrivate) WithProxy(proxy string) *WsPrivate {
 	this.Conf().SetProxy(proxy)
 	return this
@@ -47,68 +59,57 @@ func (this *WsPrivate) Connected() bool {
 	return this.ws.Connected()
 }
 
+// Ready to subscribe: connected and authorized
+func (this *WsPrivate) Ready() bool {
+	return this.ws.Ready()
+}
+
+// Authorizes and subscribes on every (re)connect
 func (this *WsPrivate) Run() {
-	this.ws.SetOnConnected(func() {
-		this.auth()
-	})
 	this.ws.Run()
 }
 
+func (this *WsPrivate) SetOnConnected(onConnected func()) {
+	this.ws.SetOnConnected(onConnected)
+}
+
+func (this *WsPrivate) SetOnDisconnected(onDisconnected func()) {
+	this.ws.SetOnDisconnected(onDisconnected)
+}
+
+func (this *WsPrivate) SetOnError(onError func(*transport.WsError)) {
+	this.ws.SetOnError(onError)
+}
+
 func (this *WsPrivate) SetOnAuth(onAuth func(bool)) {
 	this.ws.SetOnAuth(onAuth)
 }
 
 func (this *WsPrivate) auth() {
-	expires := time.Now().Unix()*1000 + 10000
-	req := fmt.Sprintf("GET/realtime%d", expires)
-	sig := hmac.New(sha256.New, []byte(this.secret))
-	sig.Write([]byte(req))
-	signature := hex.EncodeToString(sig.Sum(nil))
-	cmd := struct {
-		Name string `json:"op"`
-		Args []any  `json:"args"`
-	}{
-		Name: "auth",
-		Args: []any{
-			this.key,
-			expires,
-			signature,
-		},
+	cmd, err := transport.WsAuth(this.key, this.signer, time.Now())
+	if err != nil {
+		this.ws.log.Error("auth:", err)
+		return
 	}
 	this.ws.Send(cmd)
 }
 
-func (this *WsPrivate) SubscribePosition() bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicPosition})
-}
-func (this *WsPrivate) UnsubscribePosition() bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicPosition})
+func (this *WsPrivate) Position() *WsExecutor[[]PositionSnapshot] {
+	return NewWsExecutor[[]PositionSnapshot](&this.WsSection, Subscription{Topic: TopicPosition})
 }
 
-func (this *WsPrivate) SubscribeExecution() bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicExecution})
-}
-func (this *WsPrivate) UnsubcribeExecution() bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicExecution})
+func (this *WsPrivate) Execution() *WsExecutor[[]ExecutionSnapshot] {
+	return NewWsExecutor[[]ExecutionSnapshot](&this.WsSection, Subscription{Topic: TopicExecution})
 }
 
-func (this *WsPrivate) SubscribeOrder() bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicOrder})
-}
-func (this *WsPrivate) UnsubscribeOrder() bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicOrder})
+func (this *WsPrivate) Order() *WsExecutor[[]OrderSnapshot] {
+	return NewWsExecutor[[]OrderSnapshot](&this.WsSection, Subscription{Topic: TopicOrder})
 }
 
-func (this *WsPrivate) SubscribeStopOrder() bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicStopOrder})
-}
-func (this *WsPrivate) UnsubscribeStopOrder() bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicStopOrder})
+func (this *WsPrivate) StopOrder() *WsExecutor[[]StopOrderSnapshot] {
+	return NewWsExecutor[[]StopOrderSnapshot](&this.WsSection, Subscription{Topic: TopicStopOrder})
 }
 
-func (this *WsPrivate) SubscribeWallet() bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicWallet})
-}
-func (this *WsPrivate) UnsubscribeWallet() bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicWallet})
+func (this *WsPrivate) Wallet() *WsExecutor[[]WalletSnapshot] {
+	return NewWsExecutor[[]WalletSnapshot](&this.WsSection, Subscription{Topic: TopicWallet})
 }
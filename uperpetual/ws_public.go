@@ -6,13 +6,13 @@ import (
 )
 
 type WsPublic struct {
-	ws *WsClient
+	WsSection
 }
 
 func NewWsPublic() *WsPublic {
-	return &WsPublic{
-		ws: NewWsClient("public", "wss://stream.bybit.com/realtime_public"),
-	}
+	c := &WsPublic{}
+	c.init(NewWsClient("public", transport.DefaultProfile().WsLinearPublic))
+	return c
 }
 
 func (this *WsPublic) Shutdown() {
@@ -28,6 +28,15 @@ func (this *WsPublic) WithLog(log *ulog.Log) *WsPublic {
 	return this
 }
 
+func (this *WsPublic) WithUrl(url string) *WsPublic {
+	this.ws.WithUrl(url)
+	return this
+}
+
+func (this *WsPublic) WithProfile(profile transport.Profile) *WsPublic {
+	return this.WithUrl(profile.WsLinearPublic)
+}
+
 func (this *WsPublic) WithProxy(proxy string) *WsPublic {
 	this.Conf().SetProxy(proxy)
 	return this
@@ -41,48 +50,42 @@ func (this *WsPublic) Run() {
 	this.ws.Run()
 }
 
+func (this *WsPublic) Ready() bool {
+	return this.ws.Ready()
+}
+
 func (this *WsPublic) SetOnConnected(onConnected func()) {
 	this.ws.SetOnConnected(onConnected)
 }
 
-func (this *WsPublic) SubscribeOrderBook25(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicOrderBook25, Symbol: &symbol})
-}
-func (this *WsPublic) UnsubscribeOrderBook25(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicOrderBook25, Symbol: &symbol})
+func (this *WsPublic) SetOnDisconnected(onDisconnected func()) {
+	this.ws.SetOnDisconnected(onDisconnected)
 }
 
-func (this *WsPublic) SubscribeOrderBook200(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicOrderBook200, Interval: "100ms", Symbol: &symbol})
-}
-func (this *WsPublic) UnsubscribeOrderBook200(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicOrderBook200, Interval: "100ms", Symbol: &symbol})
+func (this *WsPublic) SetOnError(onError func(*transport.WsError)) {
+	this.ws.SetOnError(onError)
 }
 
-func (this *WsPublic) SubscribeTrade(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicTrade, Symbol: &symbol})
-}
-func (this *WsPublic) UnsubscribeTrade(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicTrade, Symbol: &symbol})
+func (this *WsPublic) OrderBook25(symbol string) *WsDeltaExecutor[OrderBook] {
+	return NewWsDeltaExecutor[OrderBook](&this.WsSection, Subscription{Topic: TopicOrderBook25, Symbol: &symbol})
 }
 
-func (this *WsPublic) SubscribeInstrument(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicInstrument, Interval: "100ms", Symbol: &symbol})
-}
-func (this *WsPublic) UnsubscribeInstrument(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicInstrument, Interval: "100ms", Symbol: &symbol})
+func (this *WsPublic) OrderBook200(symbol string) *WsDeltaExecutor[OrderBook] {
+	return NewWsDeltaExecutor[OrderBook](&this.WsSection, Subscription{Topic: TopicOrderBook200, Interval: "100ms", Symbol: &symbol})
 }
 
-func (this *WsPublic) SubscribeKline(symbol string, interval KlineInterval) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicKline, Interval: string(interval), Symbol: &symbol})
+func (this *WsPublic) Trade(symbol string) *WsExecutor[[]TradeSnapshot] {
+	return NewWsExecutor[[]TradeSnapshot](&this.WsSection, Subscription{Topic: TopicTrade, Symbol: &symbol})
 }
-func (this *WsPublic) UnsubscribeKline(symbol string, interval KlineInterval) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicKline, Interval: string(interval), Symbol: &symbol})
+
+func (this *WsPublic) Instrument(symbol string) *WsDeltaExecutor[InstrumentSnapshot] {
+	return NewWsDeltaExecutor[InstrumentSnapshot](&this.WsSection, Subscription{Topic: TopicInstrument, Interval: "100ms", Symbol: &symbol})
 }
 
-func (this *WsPublic) SubscribeLiquidation(symbol string) bool {
-	return this.ws.Subscribe(Subscription{Topic: TopicLiquidation, Symbol: &symbol})
+func (this *WsPublic) Kline(symbol string, interval KlineInterval) *WsExecutor[[]KlineSnapshot] {
+	return NewWsExecutor[[]KlineSnapshot](&this.WsSection, Subscription{Topic: TopicKline, Interval: string(interval), Symbol: &symbol})
 }
-func (this *WsPublic) UnsubscribeLiquidation(symbol string) bool {
-	return this.ws.Unsubscribe(Subscription{Topic: TopicLiquidation, Symbol: &symbol})
+
+func (this *WsPublic) Liquidation(symbol string) *WsExecutor[LiquidationSnapshot] {
+	return NewWsExecutor[LiquidationSnapshot](&this.WsSection, Subscription{Topic: TopicLiquidation, Symbol: &symbol})
 }
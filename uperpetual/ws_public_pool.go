@@ -0,0 +1,205 @@
+package uperpetual
+
+import (
+	"sync"
+
+	"github.com/ginarea/gobybit/transport"
+	"github.com/msw-x/moon/app"
+	"github.com/msw-x/moon/ulog"
+)
+
+// Public streams over several connections with the executors of WsPublic. Subscriptions
+// are spread by topic limit per connection and moved from a dropped connection to connected ones
+type WsPublicPool struct {
+	log           *ulog.Log
+	mutex         sync.Mutex
+	conns         []*WsPublic
+	shards        *transport.WsShards
+	subscriptions map[string]poolSubscription
+}
+
+func NewWsPublicPool(connections int, topicLimit int) *WsPublicPool {
+	p := &WsPublicPool{
+		log:           ulog.Empty(),
+		shards:        transport.NewWsShards(connections, topicLimit),
+		subscriptions: make(map[string]poolSubscription),
+	}
+	for i := 0; i < connections; i++ {
+		i := i
+		c := NewWsPublic()
+		c.ws.onReady = func(ready bool) {
+			if ready {
+				app.Go(func() { p.rebalance(i) })
+			} else {
+				app.Go(func() { p.drop(i) })
+			}
+		}
+		p.conns = append(p.conns, c)
+	}
+	return p
+}
+
+func (this *WsPublicPool) Shutdown() {
+	for _, c := range this.conns {
+		c.Shutdown()
+	}
+}
+
+// Connections, to configure each of them; their connect and disconnect hooks are free to use
+func (this *WsPublicPool) Connections() []*WsPublic {
+	return this.conns
+}
+
+func (this *WsPublicPool) WithLog(log *ulog.Log) *WsPublicPool {
+	this.log = log
+	for _, c := range this.conns {
+		c.WithLog(log)
+	}
+	return this
+}
+
+func (this *WsPublicPool) WithUrl(url string) *WsPublicPool {
+	for _, c := range this.conns {
+		c.WithUrl(url)
+	}
+	return this
+}
+
+func (this *WsPublicPool) WithProfile(profile transport.Profile) *WsPublicPool {
+	return this.WithUrl(profile.WsLinearPublic)
+}
+
+func (this *WsPublicPool) WithProxy(proxy string) *WsPublicPool {
+	for _, c := range this.conns {
+		c.WithProxy(proxy)
+	}
+	return this
+}
+
+func (this *WsPublicPool) Run() {
+	for _, c := range this.conns {
+		c.Run()
+	}
+}
+
+// Some connection is ready
+func (this *WsPublicPool) Ready() bool {
+	for _, c := range this.conns {
+		if c.Ready() {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *WsPublicPool) SetOnError(onError func(*transport.WsError)) {
+	for _, c := range this.conns {
+		c.SetOnError(onError)
+	}
+}
+
+func (this *WsPublicPool) OrderBook25(symbol string) *WsDeltaExecutor[OrderBook] {
+	return NewWsDeltaExecutor[OrderBook](this, Subscription{Topic: TopicOrderBook25, Symbol: &symbol})
+}
+
+func (this *WsPublicPool) OrderBook200(symbol string) *WsDeltaExecutor[OrderBook] {
+	return NewWsDeltaExecutor[OrderBook](this, Subscription{Topic: TopicOrderBook200, Interval: "100ms", Symbol: &symbol})
+}
+
+func (this *WsPublicPool) Trade(symbol string) *WsExecutor[[]TradeSnapshot] {
+	return NewWsExecutor[[]TradeSnapshot](this, Subscription{Topic: TopicTrade, Symbol: &symbol})
+}
+
+func (this *WsPublicPool) Instrument(symbol string) *WsDeltaExecutor[InstrumentSnapshot] {
+	return NewWsDeltaExecutor[InstrumentSnapshot](this, Subscription{Topic: TopicInstrument, Interval: "100ms", Symbol: &symbol})
+}
+
+func (this *WsPublicPool) Kline(symbol string, interval KlineInterval) *WsExecutor[[]KlineSnapshot] {
+	return NewWsExecutor[[]KlineSnapshot](this, Subscription{Topic: TopicKline, Interval: string(interval), Symbol: &symbol})
+}
+
+func (this *WsPublicPool) Liquidation(symbol string) *WsExecutor[LiquidationSnapshot] {
+	return NewWsExecutor[LiquidationSnapshot](this, Subscription{Topic: TopicLiquidation, Symbol: &symbol})
+}
+
+func (this *WsPublicPool) subscribe(s Subscription, f SubscriptionFunc) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	topic := s.String()
+	this.subscriptions[topic] = poolSubscription{s: s, f: f}
+	if i, ok := this.shards.Connection(topic); ok {
+		this.conns[i].subscribe(s, f)
+		return
+	}
+	this.place(topic)
+}
+
+func (this *WsPublicPool) unsubscribe(s Subscription) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	topic := s.String()
+	delete(this.subscriptions, topic)
+	if i, ok := this.shards.Remove(topic); ok {
+		this.conns[i].unsubscribe(s)
+		this.placeAll()
+	}
+}
+
+func (this *WsPublicPool) place(topic string) bool {
+	i, ok := this.shards.Place(topic, this.healthy)
+	if !ok {
+		this.log.Errorf("topic[%s]: all connections are full, pending", topic)
+		return false
+	}
+	v := this.subscriptions[topic]
+	this.conns[i].subscribe(v.s, v.f)
+	return true
+}
+
+// Connection is back: topics of dropped connections are moved to connected ones
+// and pending topics are placed
+func (this *WsPublicPool) rebalance(connected int) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	for i := range this.conns {
+		if i != connected {
+			this.evacuate(i)
+		}
+	}
+	this.placeAll()
+}
+
+func (this *WsPublicPool) placeAll() {
+	for topic := range this.subscriptions {
+		if _, ok := this.shards.Connection(topic); !ok && !this.place(topic) {
+			return
+		}
+	}
+}
+
+func (this *WsPublicPool) drop(connection int) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.evacuate(connection)
+}
+
+func (this *WsPublicPool) evacuate(connection int) {
+	if this.healthy(connection) {
+		return
+	}
+	for _, m := range this.shards.Evacuate(connection, this.healthy) {
+		v := this.subscriptions[m.Topic]
+		this.log.Infof("topic[%s]: move from connection %d to %d", m.Topic, m.From, m.To)
+		this.conns[m.From].unsubscribe(v.s)
+		this.conns[m.To].subscribe(v.s, v.f)
+	}
+}
+
+func (this *WsPublicPool) healthy(connection int) bool {
+	return this.conns[connection].Ready()
+}
+
+type poolSubscription struct {
+	s Subscription
+	f SubscriptionFunc
+}
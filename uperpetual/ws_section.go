@@ -0,0 +1,72 @@
+package uperpetual
+
+import (
+	"sync"
+)
+
+type WsSection struct {
+	ws            *WsClient
+	mutex         sync.Mutex
+	subscriptions Subscriptions
+}
+
+func (this *WsSection) init(client *WsClient) {
+	this.ws = client
+	this.subscriptions = make(Subscriptions)
+	client.section = this
+}
+
+func (this *WsSection) subscribe(s Subscription, f SubscriptionFunc) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if this.ws.Ready() {
+		this.ws.Subscribe(s)
+		this.ws.Conf().Watchdog.Watch(s.String())
+	}
+	this.subscriptions[s.String()] = f
+}
+
+func (this *WsSection) unsubscribe(s Subscription) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if this.ws.Ready() {
+		this.ws.Unsubscribe(s)
+	}
+	delete(this.subscriptions, s.String())
+	this.ws.Conf().Watchdog.Forget(s.String())
+}
+
+// Readiness is set under the lock, so a concurrent subscribe is sent
+// either here or by itself, never twice
+func (this *WsSection) subscribeAll() {
+	this.mutex.Lock()
+	for topic := range this.subscriptions {
+		this.ws.subscribe(topic)
+		this.ws.Conf().Watchdog.Watch(topic)
+	}
+	this.ws.storeReady(true)
+	this.mutex.Unlock()
+	this.ws.notifyReady(true)
+}
+
+func (this *WsSection) processTopic(m TopicMessage) (ok bool, err error) {
+	this.mutex.Lock()
+	f := this.subscriptions[m.Topic]
+	this.mutex.Unlock()
+	ok = f != nil
+	if ok {
+		this.ws.Conf().Watchdog.Touch(m.Topic)
+		err = f(m.Bin, m.Delta)
+	}
+	return
+}
+
+// Subscriptions of executors: a section of one connection or a pool
+type wsSection interface {
+	subscribe(s Subscription, f SubscriptionFunc)
+	unsubscribe(s Subscription)
+}
+
+type SubscriptionFunc func(m []byte, delta bool) error
+
+type Subscriptions map[string]SubscriptionFunc